@@ -0,0 +1,64 @@
+// Command neos-export writes Terraform configuration with import blocks for
+// the entities of a live NEOS account.
+//
+// It reads the same NEOS_* environment variables as the provider:
+//
+//	neos-export -out ./neos
+package main
+
+import (
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	neos "github.com/owain-nortal/neos-client-go"
+	"github.com/owain-nortal/terraform-provider-neos/internal/export"
+	"github.com/owain-nortal/terraform-provider-neos/internal/neosapi"
+)
+
+func main() {
+	hubHost := flag.String("hub-host", os.Getenv("NEOS_HUB_HOST"), "NEOS hub host, defaults to NEOS_HUB_HOST")
+	coreHost := flag.String("core-host", os.Getenv("NEOS_CORE_HOST"), "NEOS core host, defaults to NEOS_CORE_HOST")
+	account := flag.String("account", os.Getenv("NEOS_ACCOUNT"), "NEOS account, defaults to NEOS_ACCOUNT")
+	partition := flag.String("partition", os.Getenv("NEOS_PARTITION"), "NEOS partition, defaults to NEOS_PARTITION")
+	username := flag.String("username", os.Getenv("NEOS_USERNAME"), "NEOS username, defaults to NEOS_USERNAME")
+	out := flag.String("out", ".", "directory the .tf files are written to")
+	flag.Parse()
+
+	// The password is only read from the environment so it does not end up
+	// in the shell history.
+	password := os.Getenv("NEOS_PASSWORD")
+
+	missing := []string{}
+	for name, v := range map[string]string{"hub-host": *hubHost, "core-host": *coreHost, "account": *account, "partition": *partition, "username": *username, "NEOS_PASSWORD": password} {
+		if v == "" {
+			missing = append(missing, name)
+		}
+	}
+	if len(missing) > 0 {
+		log.Fatalf("missing required settings: %v", missing)
+	}
+
+	iamClient := neos.NewIAMClient(fmt.Sprintf("%s/api/hub/iam", *hubHost), *username, password)
+	loginResponse, err := iamClient.Login()
+	if err != nil {
+		log.Fatalf("unable to log in to NEOS: %s", err)
+	}
+	neos.AccessToken = loginResponse.AccessToken
+
+	client, err := neos.NewNeosClient(*hubHost, *coreHost, "https", *account, *partition)
+	if err != nil {
+		log.Fatalf("unable to create the NEOS client: %s", err)
+	}
+
+	coreUri, err := neosapi.ResolveURI(*coreHost, "https")
+	if err != nil {
+		log.Fatalf("invalid core host: %s", err)
+	}
+
+	err = export.New(client, coreUri, *account, *partition, os.Stderr).Run(*out)
+	if err != nil {
+		log.Fatal(err)
+	}
+}
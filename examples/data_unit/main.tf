@@ -21,12 +21,12 @@ provider "neos" {
 }
 //data "neos_links" "links" {}
 
-data "neos_data_unit" "data_units" {
+data "neos_data_units" "data_units" {
 }
 
 
 output "data_unit" {
-  value = data.neos_data_unit.data_units
+  value = data.neos_data_units.data_units
 }
 
 
@@ -99,11 +99,7 @@ resource "neos_output" "tfl-bikes-dashboard" {
   links       = ["abc"]
   contact_ids = ["eric dashy"]
   output_type = "dashboard"
-}
-
-resource "neos_link_data_product_output" "tfl_dp_output" {
-  parent_identifier = neos_data_product.tfl-bikes-product.id
-  child_identifier  = neos_output.tfl-bikes-dashboard.id
+  data_product_ids = [neos_data_product.tfl-bikes-product.id]
 }
 
 
@@ -21,14 +21,15 @@ provider "neos" {
 }
 //data "neos_data_system" "example" {}
 
-data "neos_registry_core" "cores" {
+data "neos_registry_cores" "cores" {
 }
 
 # output "edu_data_system" {
-#   value = data.neos_registry_core.cores
+#   value = data.neos_registry_cores.cores
 # }
 
 data "neos_data_system" "edu" {
+  name = "edu"
 }
 
 
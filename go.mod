@@ -14,7 +14,10 @@ require (
 
 // replace github.com/owain-nortal/neos-client-go => /home/user/git/github.com/owain-nortal/neos-client
 
-require github.com/hashicorp/terraform-plugin-framework-jsontypes v0.1.0
+require (
+	github.com/hashicorp/terraform-plugin-framework-jsontypes v0.1.0
+	github.com/hashicorp/terraform-plugin-framework-validators v0.12.0
+)
 
 require (
 	github.com/Kunde21/markdownfmt/v3 v3.1.0 // indirect
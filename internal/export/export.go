@@ -0,0 +1,380 @@
+// Package export walks a live NEOS account and writes Terraform configuration
+// with import blocks for the entities it finds.
+package export
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+
+	neos "github.com/owain-nortal/neos-client-go"
+	"github.com/owain-nortal/terraform-provider-neos/internal/neosapi"
+)
+
+// Exporter generates the Terraform configuration of a NEOS account.
+type Exporter struct {
+	client  neos.NeosClient
+	coreUri string
+	http    *neos.NeosHttp
+	account string
+	log     io.Writer
+
+	names names
+	// refs maps the identifier of an exported entity to the address of its
+	// resource so other resources reference it rather than repeat the id.
+	refs  map[string]string
+	files map[string][]*block
+}
+
+// New returns an exporter for the account using the clients the provider uses,
+// coreUri is the url of the core the catalogue entities are read from.
+func New(client neos.NeosClient, coreUri string, account string, partition string, log io.Writer) *Exporter {
+	return &Exporter{
+		client:  client,
+		coreUri: coreUri,
+		http:    neos.NewNeosHttp(account, partition),
+		account: account,
+		log:     log,
+		names:   names{},
+		refs:    map[string]string{},
+		files:   map[string][]*block{},
+	}
+}
+
+// Run exports every entity and writes a .tf file per area into dir.
+func (e *Exporter) Run(dir string) error {
+	steps := []struct {
+		name string
+		run  func() error
+	}{
+		{"accounts", e.exportAccounts},
+		{"users", e.exportUsers},
+		{"groups", e.exportGroups},
+		{"policies", e.exportPolicies},
+		{"data systems", e.exportDataSystems},
+		{"data sources", e.exportDataSources},
+		{"data units", e.exportDataUnits},
+		{"data products", e.exportDataProducts},
+		{"data product builders", e.exportDataProductBuilders},
+		{"outputs", e.exportOutputs},
+		{"links", e.exportLinks},
+	}
+
+	for _, s := range steps {
+		fmt.Fprintf(e.log, "exporting %s\n", s.name)
+		if err := s.run(); err != nil {
+			return fmt.Errorf("exporting %s: %w", s.name, err)
+		}
+	}
+
+	return e.write(dir)
+}
+
+func (e *Exporter) write(dir string) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+
+	for file, blocks := range e.files {
+		b := bytes.Buffer{}
+		b.WriteString("# Generated by neos-export, review before applying.\n")
+		for _, blk := range sortedBlocks(blocks) {
+			b.WriteString("\n")
+			b.WriteString(blk.hcl())
+		}
+
+		p := filepath.Join(dir, file)
+		if err := os.WriteFile(p, b.Bytes(), 0o644); err != nil {
+			return err
+		}
+		fmt.Fprintf(e.log, "wrote %d resources to %s\n", len(blocks), p)
+	}
+	return nil
+}
+
+// add registers a resource for the entity with the given identifier.
+func (e *Exporter) add(file string, identifier string, b *block) {
+	if identifier != "" {
+		e.refs[identifier] = b.address()
+	}
+	e.files[file] = append(e.files[file], b)
+}
+
+// newBlock returns a block for the entity with a unique resource name.
+func (e *Exporter) newBlock(resourceType string, entityName string, importID string) *block {
+	return &block{
+		resourceType: resourceType,
+		name:         e.names.next(resourceType, entityName),
+		importID:     importID,
+	}
+}
+
+// idRef references the id of an exported entity, or falls back to the
+// literal identifier when the entity was not exported.
+func (e *Exporter) idRef(identifier string) value {
+	if address, ok := e.refs[identifier]; ok {
+		return ref(address + ".id")
+	}
+	return str(identifier)
+}
+
+// accountImportID is the <account>/<id> import ID of account-scoped resources.
+func (e *Exporter) accountImportID(id string) string {
+	if e.account == "" {
+		return id
+	}
+	return e.account + "/" + id
+}
+
+// setOptional sets the attribute when the value is not empty.
+func setOptional(b *block, name string, v string) {
+	if v != "" {
+		b.set(name, str(v))
+	}
+}
+
+func (e *Exporter) exportAccounts() error {
+	list, err := e.client.AccountClient.Get("")
+	if err != nil {
+		return err
+	}
+	for _, a := range list.Accounts {
+		if a.IsSystem {
+			continue
+		}
+		b := e.newBlock("neos_account", a.Name, a.Identifier)
+		b.set("name", str(a.Name))
+		setOptional(b, "display_name", a.DisplayName)
+		setOptional(b, "description", a.Description)
+		setOptional(b, "owner", a.Owner)
+		e.add("accounts.tf", a.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportUsers() error {
+	list, err := e.client.UserClient.List("", "", e.account)
+	if err != nil {
+		return err
+	}
+	for _, u := range list.Users {
+		if u.IsSystem {
+			continue
+		}
+		b := e.newBlock("neos_user", u.Username, e.accountImportID(u.Identifier))
+		b.set("first_name", str(u.FirstName))
+		b.set("last_name", str(u.LastName))
+		setOptional(b, "username", u.Username)
+		setOptional(b, "email", u.Email)
+		if !u.Enabled {
+			b.set("enabled", ref("false"))
+		}
+		e.add("iam.tf", u.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportGroups() error {
+	groups, err := e.client.GroupClient.List(e.account)
+	if err != nil {
+		return err
+	}
+	for _, g := range groups.Groups {
+		if g.IsSystem {
+			continue
+		}
+		b := e.newBlock("neos_group", g.Name, e.accountImportID(g.Identifier))
+		b.set("name", str(g.Name))
+		setOptional(b, "description", g.Description)
+		principals := list{}
+		for _, p := range g.Principals {
+			principals = append(principals, e.idRef(p))
+		}
+		b.set("principals", principals)
+		e.add("iam.tf", g.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportPolicies() error {
+	list, err := e.client.PolicyClient.List("", e.account)
+	if err != nil {
+		return err
+	}
+	for _, p := range list.UserPolicies {
+		if p.IsSystem {
+			continue
+		}
+		userRef, ok := e.refs[p.User]
+		if !ok {
+			// The policy of a system or unexported user is left alone.
+			continue
+		}
+
+		j, err := json.MarshalIndent(p, "", "  ")
+		if err != nil {
+			return err
+		}
+		// The user id is referenced so the policy follows the user resource.
+		policy := strings.ReplaceAll(escapeTemplate(string(j)), `"`+p.User+`"`, `"${`+userRef+`.id}"`)
+
+		b := e.newBlock("neos_user_policy", strings.TrimPrefix(userRef, "neos_user."), e.accountImportID(p.User))
+		b.set("id", ref(userRef+".id"))
+		b.set("policy_json", heredoc(policy))
+		e.add("iam.tf", "", b)
+	}
+	return nil
+}
+
+// setCatalogueEntity sets the attributes every catalogue entity has, the
+// contacts and links are read from the info of the entity.
+func (e *Exporter) setCatalogueEntity(b *block, entityType string, id string, name string, description string, label string, owner string) error {
+	info, err := neosapi.EntityInfoGet(e.http, e.coreUri, entityType, id)
+	if err != nil {
+		return fmt.Errorf("reading the info of %s %s: %w", entityType, name, err)
+	}
+
+	b.set("name", str(name))
+	setOptional(b, "description", description)
+	setOptional(b, "label", label)
+	setOptional(b, "owner", owner)
+
+	contacts := list{}
+	for _, c := range info.ContactIds {
+		contacts = append(contacts, e.idRef(c))
+	}
+	b.set("contact_ids", contacts)
+
+	links := list{}
+	for _, l := range info.Links {
+		links = append(links, str(l))
+	}
+	b.set("links", links)
+	return nil
+}
+
+func (e *Exporter) exportDataSystems() error {
+	list, err := e.client.DataSystemClient.Get()
+	if err != nil {
+		return err
+	}
+	for _, d := range list.Entities {
+		b := e.newBlock("neos_data_system", d.Name, d.Identifier)
+		if err := e.setCatalogueEntity(b, "data_system", d.Identifier, d.Name, d.Description, d.Label, d.Owner); err != nil {
+			return err
+		}
+		e.add("catalogue.tf", d.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportDataSources() error {
+	list, err := e.client.DataSourceClient.Get()
+	if err != nil {
+		return err
+	}
+	for _, d := range list.Entities {
+		b := e.newBlock("neos_data_source", d.Name, d.Identifier)
+		b.comment = "connection_json and secret_values are not exported, add them before applying."
+		if err := e.setCatalogueEntity(b, "data_source", d.Identifier, d.Name, d.Description, d.Label, d.Owner); err != nil {
+			return err
+		}
+		e.add("catalogue.tf", d.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportDataUnits() error {
+	list, err := e.client.DataUnitClient.Get()
+	if err != nil {
+		return err
+	}
+	for _, d := range list.Entities {
+		b := e.newBlock("neos_data_unit", d.Name, d.Identifier)
+		if err := e.setCatalogueEntity(b, "data_unit", d.Identifier, d.Name, d.Description, d.Label, d.Owner); err != nil {
+			return err
+		}
+		e.add("catalogue.tf", d.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportDataProducts() error {
+	list, err := e.client.DataProductClient.Get()
+	if err != nil {
+		return err
+	}
+	for _, d := range list.Entities {
+		b := e.newBlock("neos_data_product", d.Name, d.Identifier)
+		if err := e.setCatalogueEntity(b, "data_product", d.Identifier, d.Name, d.Description, d.Label, d.Owner); err != nil {
+			return err
+		}
+		e.add("catalogue.tf", d.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportDataProductBuilders() error {
+	list, err := e.client.DataProductClient.Get()
+	if err != nil {
+		return err
+	}
+	for _, d := range list.Entities {
+		builder, err := e.client.DataProductClient.DataProductBuilderGet(d.Identifier)
+		if err != nil {
+			// A data product without a builder returns an error.
+			fmt.Fprintf(e.log, "skipping builder of data product %s: %s\n", d.Name, err)
+			continue
+		}
+
+		pretty := bytes.Buffer{}
+		if err := json.Indent(&pretty, []byte(builder), "", "  "); err != nil || pretty.Len() == 0 || pretty.String() == "{}" {
+			continue
+		}
+
+		b := e.newBlock("neos_data_product_builder", d.Name, d.Identifier)
+		b.set("id", e.idRef(d.Identifier))
+		b.set("builder_json", heredoc(escapeTemplate(pretty.String())))
+		e.add("catalogue.tf", "", b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportOutputs() error {
+	list, err := e.client.OutputClient.Get()
+	if err != nil {
+		return err
+	}
+	for _, o := range list.Entities {
+		b := e.newBlock("neos_output", o.Name, o.Identifier)
+		if err := e.setCatalogueEntity(b, "output", o.Identifier, o.Name, o.Description, o.Label, o.Owner); err != nil {
+			return err
+		}
+		setOptional(b, "output_type", o.OutputType)
+		e.add("catalogue.tf", o.Identifier, b)
+	}
+	return nil
+}
+
+func (e *Exporter) exportLinks() error {
+	list, err := e.client.LinksClient.Get()
+	if err != nil {
+		return err
+	}
+	for _, l := range list.Links {
+		if !neosapi.LinkPairs[[2]string{l.Parent.EntityType, l.Child.EntityType}] {
+			fmt.Fprintf(e.log, "skipping link from %s %s to %s %s\n", l.Parent.EntityType, l.Parent.Name, l.Child.EntityType, l.Child.Name)
+			continue
+		}
+
+		b := e.newBlock("neos_link", l.Parent.Name+"_"+l.Child.Name, l.Parent.Identifier+"/"+l.Child.Identifier)
+		b.set("parent_id", e.idRef(l.Parent.Identifier))
+		b.set("child_id", e.idRef(l.Child.Identifier))
+		e.add("links.tf", "", b)
+	}
+	return nil
+}
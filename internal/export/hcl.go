@@ -0,0 +1,154 @@
+package export
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// value is an HCL attribute value.
+type value interface {
+	hcl(indent string) string
+}
+
+// str is a quoted string literal.
+type str string
+
+// ref is an expression such as neos_data_unit.orders.id, written unquoted.
+type ref string
+
+// list is a list of values.
+type list []value
+
+// heredoc is a multi line string, ${...} sequences that are not references
+// must already be escaped by the caller.
+type heredoc string
+
+func (s str) hcl(_ string) string {
+	return quote(string(s))
+}
+
+func (r ref) hcl(_ string) string {
+	return string(r)
+}
+
+func (l list) hcl(indent string) string {
+	if len(l) == 0 {
+		return "[]"
+	}
+	items := []string{}
+	for _, v := range l {
+		items = append(items, v.hcl(indent))
+	}
+	return "[" + strings.Join(items, ", ") + "]"
+}
+
+func (h heredoc) hcl(indent string) string {
+	lines := strings.Split(strings.TrimRight(string(h), "\n"), "\n")
+	b := strings.Builder{}
+	b.WriteString("<<-EOT\n")
+	for _, l := range lines {
+		b.WriteString(indent + "  " + l + "\n")
+	}
+	b.WriteString(indent + "EOT")
+	return b.String()
+}
+
+// quote returns s as an HCL string literal, template sequences are escaped
+// so the value is taken literally.
+func quote(s string) string {
+	s = strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`, "\r", `\r`, "\t", `\t`).Replace(s)
+	return `"` + escapeTemplate(s) + `"`
+}
+
+// escapeTemplate escapes the ${ and %{ sequences HCL would interpolate.
+func escapeTemplate(s string) string {
+	return strings.NewReplacer("${", "$${", "%{", "%%{").Replace(s)
+}
+
+type attribute struct {
+	name  string
+	value value
+}
+
+// block is a resource with the import block that adopts it.
+type block struct {
+	resourceType string
+	name         string
+	attributes   []attribute
+	importID     string
+	comment      string
+}
+
+func (b *block) set(name string, v value) {
+	b.attributes = append(b.attributes, attribute{name: name, value: v})
+}
+
+// address is the Terraform address of the resource.
+func (b *block) address() string {
+	return b.resourceType + "." + b.name
+}
+
+func (b *block) hcl() string {
+	w := strings.Builder{}
+	if b.comment != "" {
+		for _, l := range strings.Split(b.comment, "\n") {
+			w.WriteString("# " + l + "\n")
+		}
+	}
+	if b.importID != "" {
+		fmt.Fprintf(&w, "import {\n  to = %s\n  id = %s\n}\n\n", b.address(), quote(b.importID))
+	}
+
+	width := 0
+	for _, a := range b.attributes {
+		if len(a.name) > width {
+			width = len(a.name)
+		}
+	}
+
+	fmt.Fprintf(&w, "resource %s %s {\n", quote(b.resourceType), quote(b.name))
+	for _, a := range b.attributes {
+		fmt.Fprintf(&w, "  %-*s = %s\n", width, a.name, a.value.hcl("  "))
+	}
+	w.WriteString("}\n")
+	return w.String()
+}
+
+var invalidNameChars = regexp.MustCompile(`[^a-z0-9_]+`)
+
+// names hands out unique Terraform resource names per resource type.
+type names map[string]map[string]bool
+
+// next returns a resource name for the entity name, suffixed with a number
+// when the name is already used for the resource type.
+func (n names) next(resourceType string, entityName string) string {
+	base := strings.Trim(invalidNameChars.ReplaceAllString(strings.ToLower(entityName), "_"), "_")
+	if base == "" {
+		base = "unnamed"
+	}
+	if base[0] >= '0' && base[0] <= '9' {
+		base = "_" + base
+	}
+
+	used, ok := n[resourceType]
+	if !ok {
+		used = map[string]bool{}
+		n[resourceType] = used
+	}
+
+	name := base
+	for i := 2; used[name]; i++ {
+		name = fmt.Sprintf("%s_%d", base, i)
+	}
+	used[name] = true
+	return name
+}
+
+// sortedBlocks returns the blocks ordered by address so the output is stable.
+func sortedBlocks(blocks []*block) []*block {
+	rtn := append([]*block{}, blocks...)
+	sort.SliceStable(rtn, func(i, j int) bool { return rtn[i].address() < rtn[j].address() })
+	return rtn
+}
@@ -0,0 +1,133 @@
+package export
+
+import (
+	"testing"
+)
+
+func TestQuote(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		want string
+	}{
+		{name: "plain", in: "orders", want: `"orders"`},
+		{name: "empty", in: "", want: `""`},
+		{name: "quotes and backslashes", in: `a "b" \c`, want: `"a \"b\" \\c"`},
+		{name: "control characters", in: "a\nb\rc\td", want: `"a\nb\rc\td"`},
+		{name: "interpolation", in: "${var.x}", want: `"$${var.x}"`},
+		{name: "directive", in: "%{if x}", want: `"%%{if x}"`},
+		{name: "lone dollar and percent", in: "$5 100%", want: `"$5 100%"`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := quote(tt.in); got != tt.want {
+				t.Errorf("quote(%q) = %s, want %s", tt.in, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEscapeTemplate(t *testing.T) {
+	tests := []struct {
+		in   string
+		want string
+	}{
+		{in: "select 1", want: "select 1"},
+		{in: "${a} and %{b}", want: "$${a} and %%{b}"},
+		{in: "$${a}", want: "$$${a}"},
+		{in: "$ {a} % {b}", want: "$ {a} % {b}"},
+	}
+	for _, tt := range tests {
+		if got := escapeTemplate(tt.in); got != tt.want {
+			t.Errorf("escapeTemplate(%q) = %q, want %q", tt.in, got, tt.want)
+		}
+	}
+}
+
+func TestValueHcl(t *testing.T) {
+	tests := []struct {
+		name  string
+		value value
+		want  string
+	}{
+		{name: "string", value: str("a\"b"), want: `"a\"b"`},
+		{name: "ref", value: ref("neos_data_unit.orders.id"), want: "neos_data_unit.orders.id"},
+		{name: "empty list", value: list{}, want: "[]"},
+		{name: "list", value: list{str("a"), ref("b.c.id")}, want: `["a", b.c.id]`},
+		{
+			name:  "heredoc",
+			value: heredoc("select *\nfrom orders\n"),
+			want:  "<<-EOT\n    select *\n    from orders\n  EOT",
+		},
+		{
+			name:  "heredoc without trailing newline",
+			value: heredoc("select 1"),
+			want:  "<<-EOT\n    select 1\n  EOT",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.value.hcl("  "); got != tt.want {
+				t.Errorf("hcl() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestBlockHcl(t *testing.T) {
+	b := &block{
+		resourceType: "neos_data_unit",
+		name:         "orders",
+		importID:     "abc",
+		comment:      "owner: jane\nlabel: sales",
+	}
+	b.set("name", str("Orders"))
+	b.set("description", str(""))
+
+	want := `# owner: jane
+# label: sales
+import {
+  to = neos_data_unit.orders
+  id = "abc"
+}
+
+resource "neos_data_unit" "orders" {
+  name        = "Orders"
+  description = ""
+}
+`
+	if got := b.hcl(); got != want {
+		t.Errorf("hcl() =\n%s\nwant\n%s", got, want)
+	}
+
+	plain := &block{resourceType: "neos_link", name: "a_b"}
+	plain.set("parent_id", ref("neos_data_unit.a.id"))
+	want = "resource \"neos_link\" \"a_b\" {\n  parent_id = neos_data_unit.a.id\n}\n"
+	if got := plain.hcl(); got != want {
+		t.Errorf("hcl() =\n%s\nwant\n%s", got, want)
+	}
+}
+
+func TestNamesNext(t *testing.T) {
+	n := names{}
+	tests := []struct {
+		resourceType string
+		entityName   string
+		want         string
+	}{
+		{resourceType: "neos_data_unit", entityName: "Orders", want: "orders"},
+		{resourceType: "neos_data_unit", entityName: "orders", want: "orders_2"},
+		{resourceType: "neos_data_unit", entityName: "ORDERS", want: "orders_3"},
+		{resourceType: "neos_data_product", entityName: "orders", want: "orders"},
+		{resourceType: "neos_data_unit", entityName: "Sales - EU (2024)", want: "sales_eu_2024"},
+		{resourceType: "neos_data_unit", entityName: "2024 sales", want: "_2024_sales"},
+		{resourceType: "neos_data_unit", entityName: "--", want: "unnamed"},
+		{resourceType: "neos_data_unit", entityName: "", want: "unnamed_2"},
+		{resourceType: "neos_data_unit", entityName: "orders_2", want: "orders_2_2"},
+	}
+	for _, tt := range tests {
+		if got := n.next(tt.resourceType, tt.entityName); got != tt.want {
+			t.Errorf("next(%q, %q) = %q, want %q", tt.resourceType, tt.entityName, got, tt.want)
+		}
+	}
+}
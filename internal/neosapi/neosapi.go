@@ -0,0 +1,51 @@
+// Package neosapi holds the NEOS calls and rules that neos-client-go does not
+// cover and that both the provider and neos-export need.
+package neosapi
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+// ResolveURI adds the scheme to the host unless the host already has one,
+// this matches how neos-client-go builds its urls.
+func ResolveURI(host string, scheme string) (string, error) {
+	hostUri, err := url.Parse(host)
+	if err != nil {
+		return "", err
+	}
+
+	if hostUri.Scheme != "" {
+		return host, nil
+	}
+	return fmt.Sprintf("%s://%s", scheme, host), nil
+}
+
+// EntityInfo is the info of a data system, data source, data unit, data
+// product or output, the contacts and links are not in the list responses.
+type EntityInfo struct {
+	Owner      string   `json:"owner"`
+	ContactIds []string `json:"contact_ids"`
+	Links      []string `json:"links"`
+}
+
+// EntityInfoGet reads the info of the catalogue entity from the core at
+// coreUri.
+func EntityInfoGet(client *neos.NeosHttp, coreUri string, entityType string, id string) (EntityInfo, error) {
+	var rtn EntityInfo
+	requestURL := fmt.Sprintf("%s/api/gateway/v2/%s/%s/info", coreUri, entityType, id)
+	err := client.GetUnmarshal(requestURL, http.StatusOK, &rtn)
+	return rtn, err
+}
+
+// LinkPairs are the parent and child entity types NEOS can link.
+var LinkPairs = map[[2]string]bool{
+	{"data_system", "data_source"}:   true,
+	{"data_source", "data_unit"}:     true,
+	{"data_unit", "data_product"}:    true,
+	{"data_product", "data_product"}: true,
+	{"data_product", "output"}:       true,
+}
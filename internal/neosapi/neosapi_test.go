@@ -0,0 +1,25 @@
+package neosapi
+
+import (
+	"testing"
+)
+
+func TestResolveURI(t *testing.T) {
+	tests := []struct {
+		host string
+		want string
+	}{
+		{host: "core.neosdata.net", want: "https://core.neosdata.net"},
+		{host: "https://core.neosdata.net", want: "https://core.neosdata.net"},
+		{host: "http://localhost:8080", want: "http://localhost:8080"},
+	}
+	for _, tt := range tests {
+		got, err := ResolveURI(tt.host, "https")
+		if err != nil {
+			t.Fatalf("ResolveURI(%q) error = %s", tt.host, err)
+		}
+		if got != tt.want {
+			t.Errorf("ResolveURI(%q) = %q, want %q", tt.host, got, tt.want)
+		}
+	}
+}
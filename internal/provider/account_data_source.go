@@ -3,6 +3,7 @@ package provider
 import (
 	"context"
 	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -15,8 +16,9 @@ func NewAccountDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &accountDataSource{}
-	_ datasource.DataSourceWithConfigure = &accountDataSource{}
+	_ datasource.DataSource                     = &accountDataSource{}
+	_ datasource.DataSourceWithConfigure        = &accountDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &accountDataSource{}
 )
 
 type accountDataSource struct {
@@ -31,30 +33,31 @@ func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 	tflog.Info(ctx, "accountDataSource READ")
 
-	var state AccountDataSourceModel
+	var config AccountModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.Get("")
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Data System List", err.Error())
+		resp.Diagnostics.AddError("Unable to Read Account List", err.Error())
 		return
 	}
 
-	// Map response body to model
-	for _, ds := range list.Accounts {
-		accountState := AccountModel{
-			Identifier:  types.StringValue(ds.Identifier),
-			Name:        types.StringValue(ds.Name),
-			Description: types.StringValue(ds.Description),
-			DisplayName: types.StringValue(ds.DisplayName),
-			Owner:       types.StringValue(ds.Owner),
-			Urn:         types.StringValue(ds.Urn),
-		}
-		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Identifier))
-		state.AccountModel = append(state.AccountModel, accountState)
+	lookup := entityLookup{ID: config.Identifier, Name: config.Name, URN: config.Urn}
+	matches := filterEntities(list.Accounts, func(a neos.Account) bool {
+		return lookup.matches(a.Identifier, a.Name, a.Urn)
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "account", lookup.String(), len(matches)) {
+		return
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
+	state := newAccountModel(matches[0])
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -62,7 +65,6 @@ func (d *accountDataSource) Read(ctx context.Context, req datasource.ReadRequest
 
 }
 
-// Configure adds the provider configured client to the data source.
 func (d *accountDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	tflog.Info(ctx, "Data source configure")
 
@@ -70,58 +72,56 @@ func (d *accountDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected Account Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected Account Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
 	}
 
 	d.client = &client.AccountClient
 }
 
+func (d *accountDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
+}
+
 func (d *accountDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := accountDataSourceAttributes()
+	setLookupAttributes(attributes, "account", "id", "name", "urn")
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"datasystems": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"display_name": schema.StringAttribute{
-							Computed: true,
-						},
-						"is_system": schema.BoolAttribute{
-							Computed: true,
-						},
-						"created_at": schema.StringAttribute{
-							Computed: true,
-						},
-						"owner": schema.StringAttribute{
-							Computed: true,
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type AccountDataSourceModel struct {
-	AccountModel []AccountModel `tfsdk:"account"`
+// accountDataSourceAttributes are the computed attributes of an account shared
+// by the neos_account and neos_accounts data sources.
+func accountDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"urn": schema.StringAttribute{
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"display_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"is_system": schema.BoolAttribute{
+			Computed: true,
+		},
+		"owner": schema.StringAttribute{
+			Computed: true,
+		},
+	}
 }
 
-// coffeesModel maps coffees schema data.
 type AccountModel struct {
 	Identifier  types.String `tfsdk:"id"`
 	Description types.String `tfsdk:"description"`
@@ -131,3 +131,15 @@ type AccountModel struct {
 	IsSystem    types.Bool   `tfsdk:"is_system"`
 	DisplayName types.String `tfsdk:"display_name"`
 }
+
+func newAccountModel(a neos.Account) AccountModel {
+	return AccountModel{
+		Identifier:  types.StringValue(a.Identifier),
+		Name:        types.StringValue(a.Name),
+		Description: types.StringValue(a.Description),
+		DisplayName: types.StringValue(a.DisplayName),
+		Owner:       types.StringValue(a.Owner),
+		Urn:         types.StringValue(a.Urn),
+		IsSystem:    types.BoolValue(a.IsSystem),
+	}
+}
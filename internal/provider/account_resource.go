@@ -6,7 +6,6 @@ import (
 
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -258,7 +257,7 @@ func (r *accountResource) Configure(_ context.Context, req resource.ConfigureReq
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Account Configure Type", fmt.Sprintf("Expected *neos.AccountClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -269,7 +268,14 @@ func (r *accountResource) Configure(_ context.Context, req resource.ConfigureReq
 
 }
 
+// ImportState imports the resource by its id or NEOS URN.
 func (r *accountResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIDOrURN(ctx, req, resp, "id", func(urn string, _ string) (string, bool, error) {
+		list, err := r.client.Get("")
+		if err != nil {
+			return "", false, err
+		}
+		id, found := findByURN(list.Accounts, urn, func(e neos.Account) string { return e.Urn }, func(e neos.Account) string { return e.Identifier })
+		return id, found, nil
+	})
 }
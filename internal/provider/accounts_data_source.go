@@ -0,0 +1,107 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewAccountsDataSource() datasource.DataSource {
+	return &accountsDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &accountsDataSource{}
+	_ datasource.DataSourceWithConfigure = &accountsDataSource{}
+)
+
+type accountsDataSource struct {
+	client *neos.AccountClient
+}
+
+func (d *accountsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_accounts"
+}
+
+func (d *accountsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "accountsDataSource READ")
+
+	var state AccountsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, ok := nameRegexFilter(&resp.Diagnostics, state.NameRegex)
+	if !ok {
+		return
+	}
+
+	list, err := d.client.Get("")
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Account List", err.Error())
+		return
+	}
+
+	matches := filterEntities(list.Accounts, func(a neos.Account) bool {
+		return nameRegex.MatchString(a.Name) && matchesFilter(state.Owner, a.Owner)
+	})
+
+	state.Accounts = []AccountModel{}
+	for _, a := range matches {
+		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", a.Identifier))
+		state.Accounts = append(state.Accounts, newAccountModel(a))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (d *accountsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected accountsDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = &client.AccountClient
+}
+
+func (d *accountsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": pluralFilterAttribute("Only return accounts whose name matches this regular expression"),
+			"owner":      pluralFilterAttribute("Only return accounts with this owner"),
+			"accounts": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The accounts matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: accountDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+type AccountsDataSourceModel struct {
+	NameRegex types.String   `tfsdk:"name_regex"`
+	Owner     types.String   `tfsdk:"owner"`
+	Accounts  []AccountModel `tfsdk:"accounts"`
+}
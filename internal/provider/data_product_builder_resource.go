@@ -12,6 +12,8 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	neos "github.com/owain-nortal/neos-client-go"
+	"golang.org/x/exp/slices"
+	"sort"
 	"time"
 )
 
@@ -22,13 +24,15 @@ func NewDataProductBuilderResource() resource.Resource {
 
 // DataProductBuilderResource is the resource implementation.
 type DataProductBuilderResource struct {
-	client *neos.DataProductClient
+	client      *neos.DataProductClient
+	linksClient *neos.LinksClient
 }
 
 var (
 	_ resource.Resource                = &DataProductBuilderResource{}
 	_ resource.ResourceWithConfigure   = &DataProductBuilderResource{}
 	_ resource.ResourceWithImportState = &DataProductBuilderResource{}
+	_ resource.ResourceWithModifyPlan  = &DataProductBuilderResource{}
 )
 
 // Metadata returns the resource type name.
@@ -51,11 +55,25 @@ func (r *DataProductBuilderResource) Schema(_ context.Context, _ resource.Schema
 			},
 
 			"dataunit_datasource_linkids": schema.ListAttribute{
+				ElementType:        types.StringType,
+				Computed:           false,
+				Required:           false,
+				Optional:           true,
+				Description:        "The link ids of the data unit data source, to ensure the correct dependency graph is created",
+				DeprecationMessage: "The input data units are now derived from builder_json, this attribute is no longer needed and will be removed in a future release",
+			},
+			"input_data_unit_ids": schema.ListAttribute{
 				ElementType: types.StringType,
-				Computed:    false,
-				Required:    true,
+				Computed:    true,
+				Required:    false,
 				Optional:    false,
-				Description: "The link ids of the data unit data source, to ensure the correct dependency graph is created",
+				Description: "The ids of the data units used as inputs in the builder json",
+			},
+			"manage_links": schema.BoolAttribute{
+				Computed:    false,
+				Required:    false,
+				Optional:    true,
+				Description: "When true the links from each input data unit to the data product are created and removed with the builder, setting it back to false removes the links it created",
 			},
 
 			"builder_json": schema.StringAttribute{
@@ -78,10 +96,44 @@ func (r *DataProductBuilderResource) Schema(_ context.Context, _ resource.Schema
 type DataProductBuilderResourceModel struct {
 	ID                        types.String `tfsdk:"id"`
 	DataUnitDataSourceLinkIds types.List   `tfsdk:"dataunit_datasource_linkids"`
+	InputDataUnitIds          types.List   `tfsdk:"input_data_unit_ids"`
+	ManageLinks               types.Bool   `tfsdk:"manage_links"`
 	LastUpdated               types.String `tfsdk:"last_updated"`
 	BuilderJson               types.String `tfsdk:"builder_json"`
 }
 
+// builderInputDataUnitIds returns the ids of the data units referenced in the
+// inputs section of the builder json, sorted so the plan is stable.
+func builderInputDataUnitIds(builderJson string) ([]string, error) {
+	var definition struct {
+		Inputs map[string]struct {
+			InputType  string `json:"input_type"`
+			Identifier string `json:"identifier"`
+		} `json:"inputs"`
+	}
+
+	rtn := []string{}
+	if builderJson == "" {
+		return rtn, nil
+	}
+
+	err := json.Unmarshal([]byte(builderJson), &definition)
+	if err != nil {
+		return rtn, err
+	}
+
+	for _, v := range definition.Inputs {
+		if v.InputType != "data_unit" || v.Identifier == "" {
+			continue
+		}
+		if !slices.Contains(rtn, v.Identifier) {
+			rtn = append(rtn, v.Identifier)
+		}
+	}
+	sort.Strings(rtn)
+	return rtn, nil
+}
+
 // type DataProductSchemaModel struct {
 // 	ProductType types.String                    `tfsdk:"product_type"`
 // 	Fields      []DataProductFieldResourceModel `tfsdk:"fields"`
@@ -114,6 +166,20 @@ func (r *DataProductBuilderResource) Create(ctx context.Context, req resource.Cr
 
 	tflog.Debug(ctx, builderJson)
 
+	inputIds, err := builderInputDataUnitIds(builderJson)
+	if err != nil {
+		resp.Diagnostics.AddError("Error invalid json data product builder ", "the builder json is invalid: "+err.Error())
+		return
+	}
+
+	if plan.ManageLinks.ValueBool() {
+		err = r.syncInputLinks(ctx, plan.ID.ValueString(), inputIds, []string{})
+		if err != nil {
+			resp.Diagnostics.AddError("Error linking data product builder inputs", "Could not link input data units to the data product, unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	if builderJson != "" {
 		if json.Valid([]byte(builderJson)) {
 			tflog.Info(ctx, fmt.Sprintf("DataProductBuilderResource Create builder put %s", plan.ID.ValueString()))
@@ -133,9 +199,13 @@ func (r *DataProductBuilderResource) Create(ctx context.Context, req resource.Cr
 
 	//plan.ID = types.StringValue(plan.ID.ValueString())
 
+	plan.InputDataUnitIds, diags = types.ListValueFrom(ctx, types.StringType, inputIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
-	foo := plan.DataUnitDataSourceLinkIds
-	plan.DataUnitDataSourceLinkIds = foo
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -165,6 +235,17 @@ func (r *DataProductBuilderResource) Read(ctx context.Context, req resource.Read
 
 	state.BuilderJson = types.StringValue(dataProductbuilderJson)
 
+	inputIds, err := builderInputDataUnitIds(dataProductbuilderJson)
+	if err != nil {
+		tflog.Info(ctx, fmt.Sprintf("DataProductBuilderResource Read could not parse builder json inputs %s", err.Error()))
+	} else {
+		state.InputDataUnitIds, diags = types.ListValueFrom(ctx, types.StringType, inputIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -186,7 +267,49 @@ func (r *DataProductBuilderResource) Update(ctx context.Context, req resource.Up
 		return
 	}
 
-	_, err := r.client.DataProductBuilderPut(ctx, plan.ID.ValueString(), plan.BuilderJson.ValueString())
+	var state DataProductBuilderResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	inputIds, err := builderInputDataUnitIds(plan.BuilderJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error invalid json data product builder ", "the builder json is invalid: "+err.Error())
+		return
+	}
+
+	if plan.ManageLinks.ValueBool() {
+		previousIds := []string{}
+		if state.ManageLinks.ValueBool() {
+			diags = state.InputDataUnitIds.ElementsAs(ctx, &previousIds, false)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		err = r.syncInputLinks(ctx, plan.ID.ValueString(), inputIds, previousIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Error linking data product builder inputs", "Could not update the links from the input data units to the data product, unexpected error: "+err.Error())
+			return
+		}
+	} else if state.ManageLinks.ValueBool() {
+		// Turning manage_links off removes the links the builder created.
+		previousIds := []string{}
+		diags = state.InputDataUnitIds.ElementsAs(ctx, &previousIds, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err = r.syncInputLinks(ctx, plan.ID.ValueString(), []string{}, previousIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Error unlinking data product builder inputs", "Could not remove the links from the input data units to the data product, unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	_, err = r.client.DataProductBuilderPut(ctx, plan.ID.ValueString(), plan.BuilderJson.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating data product builder ", "Could not put data product builder, unexpected error: "+err.Error())
 		return
@@ -197,9 +320,12 @@ func (r *DataProductBuilderResource) Update(ctx context.Context, req resource.Up
 		resp.Diagnostics.AddError("Error Reading NEOS data product builder after update", "Could not read NEOS data product builder ID "+plan.ID.ValueString()+": "+err.Error())
 		return
 	}
-	foo := plan.DataUnitDataSourceLinkIds
-	plan.DataUnitDataSourceLinkIds = foo
 	plan.BuilderJson = types.StringValue(dpbj)
+	plan.InputDataUnitIds, diags = types.ListValueFrom(ctx, types.StringType, inputIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
@@ -227,6 +353,20 @@ func (r *DataProductBuilderResource) Delete(ctx context.Context, req resource.De
 
 	tflog.Info(ctx, fmt.Sprintf("DP Builder Delete ID: %s", plan.ID.ValueString()))
 
+	if plan.ManageLinks.ValueBool() {
+		previousIds := []string{}
+		diags = plan.InputDataUnitIds.ElementsAs(ctx, &previousIds, false)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		err := r.syncInputLinks(ctx, plan.ID.ValueString(), []string{}, previousIds)
+		if err != nil {
+			resp.Diagnostics.AddError("Error unlinking data product builder inputs", "Could not remove the links from the input data units to the data product, unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	// Delete the data product builder json not currently supported
 
 	// err := r.client.DataProductBuilderDelete(plan.ID.ValueString())
@@ -242,7 +382,7 @@ func (r *DataProductBuilderResource) Configure(_ context.Context, req resource.C
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.DataProductClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -250,9 +390,92 @@ func (r *DataProductBuilderResource) Configure(_ context.Context, req resource.C
 	}
 
 	r.client = &client.DataProductClient
+	r.linksClient = &client.LinksClient
+}
+
+// ModifyPlan works out the input data units from the builder json so they are
+// known at plan time.
+func (r *DataProductBuilderResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan DataProductBuilderResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if plan.BuilderJson.IsUnknown() {
+		return
+	}
+
+	inputIds, err := builderInputDataUnitIds(plan.BuilderJson.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("builder_json"), "Error invalid json data product builder ", "the builder json is invalid: "+err.Error())
+		return
+	}
+
+	inputList, diags := types.ListValueFrom(ctx, types.StringType, inputIds)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.Plan.SetAttribute(ctx, path.Root("input_data_unit_ids"), inputList)
+	resp.Diagnostics.Append(diags...)
 }
 
+// syncInputLinks creates a data unit to data product link for each wanted input
+// and removes the links for inputs that were previously managed but are no
+// longer used.
+func (r *DataProductBuilderResource) syncInputLinks(ctx context.Context, dataProductId string, wanted []string, previous []string) error {
+	linksList, err := r.linksClient.Get()
+	if err != nil {
+		return err
+	}
+
+	linked := []string{}
+	for _, l := range linksList.Links {
+		if l.Child.Identifier == dataProductId && l.Parent.EntityType == "data_unit" {
+			linked = append(linked, l.Parent.Identifier)
+		}
+	}
+
+	for _, id := range wanted {
+		if slices.Contains(linked, id) {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("DataProductBuilderResource linking data unit %s to data product %s", id, dataProductId))
+		_, err := r.linksClient.LinkDataUnitToDataProduct(ctx, id, dataProductId)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range previous {
+		if slices.Contains(wanted, id) || !slices.Contains(linked, id) {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("DataProductBuilderResource unlinking data unit %s from data product %s", id, dataProductId))
+		err := r.linksClient.DeleteLinkDataUnitToDataProduct(ctx, id, dataProductId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ImportState imports the builder by the id or NEOS URN of its data product.
 func (r *DataProductBuilderResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIDOrURN(ctx, req, resp, "id", func(urn string, _ string) (string, bool, error) {
+		list, err := r.client.Get()
+		if err != nil {
+			return "", false, err
+		}
+		id, found := findByURN(list.Entities, urn, func(e neos.DataProduct) string { return e.Urn }, func(e neos.DataProduct) string { return e.Identifier })
+		return id, found, nil
+	})
 }
@@ -0,0 +1,135 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// neosColumnTypes are the column types of a data product schema field and the
+// meta keys each of them requires. Column types are matched in upper case,
+// like the schema change classifier compares them.
+var neosColumnTypes = map[string][]string{
+	"BIGINT":    {},
+	"BINARY":    {},
+	"BOOLEAN":   {},
+	"CHAR":      {"length"},
+	"DATE":      {},
+	"DECIMAL":   {"precision", "scale"},
+	"DOUBLE":    {},
+	"FLOAT":     {},
+	"INT":       {},
+	"INTEGER":   {},
+	"REAL":      {},
+	"SMALLINT":  {},
+	"STRING":    {},
+	"TIMESTAMP": {},
+	"TINYINT":   {},
+	"VARCHAR":   {"length"},
+}
+
+// maxDecimalPrecision is the most digits a DECIMAL column can hold.
+const maxDecimalPrecision = 38
+
+func neosColumnTypeNames() []string {
+	rtn := make([]string, 0, len(neosColumnTypes))
+	for t := range neosColumnTypes {
+		rtn = append(rtn, t)
+	}
+	sort.Strings(rtn)
+	return rtn
+}
+
+var _ validator.Object = columnDataTypeValidator{}
+
+// columnDataTypeValidator checks the meta of a data_type has the keys its
+// column_type requires and that they hold valid numbers.
+type columnDataTypeValidator struct{}
+
+func (v columnDataTypeValidator) Description(_ context.Context) string {
+	return "meta must have length for CHAR and VARCHAR columns and precision and scale for DECIMAL columns"
+}
+
+func (v columnDataTypeValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v columnDataTypeValidator) ValidateObject(_ context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	attrs := req.ConfigValue.Attributes()
+	columnType, ok := attrs["column_type"].(types.String)
+	if !ok || columnType.IsNull() || columnType.IsUnknown() {
+		return
+	}
+	required, ok := neosColumnTypes[strings.ToUpper(columnType.ValueString())]
+	if !ok || len(required) == 0 {
+		// An unknown column type is reported by the column_type validator.
+		return
+	}
+
+	meta, ok := attrs["meta"].(types.Map)
+	if !ok || meta.IsUnknown() {
+		return
+	}
+	metaPath := req.Path.AtName("meta")
+
+	values := map[string]int{}
+	missing := []string{}
+	for _, key := range required {
+		element, ok := meta.Elements()[key]
+		if !ok {
+			missing = append(missing, key)
+			continue
+		}
+		s, ok := element.(types.String)
+		if !ok || s.IsUnknown() {
+			return
+		}
+		i, err := strconv.Atoi(s.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(metaPath.AtMapKey(key), "Invalid column meta", fmt.Sprintf("The %s of a %s column must be a whole number, got %q.", key, columnType.ValueString(), s.ValueString()))
+			return
+		}
+		values[key] = i
+	}
+	if len(missing) > 0 {
+		resp.Diagnostics.AddAttributeError(metaPath, "Missing column meta", fmt.Sprintf("A %s column needs the meta %s.", columnType.ValueString(), strings.Join(missing, " and ")))
+		return
+	}
+
+	if length, ok := values["length"]; ok && length < 1 {
+		resp.Diagnostics.AddAttributeError(metaPath.AtMapKey("length"), "Invalid column meta", fmt.Sprintf("The length of a %s column must be at least 1, got %d.", columnType.ValueString(), length))
+	}
+	if precision, ok := values["precision"]; ok {
+		if precision < 1 || precision > maxDecimalPrecision {
+			resp.Diagnostics.AddAttributeError(metaPath.AtMapKey("precision"), "Invalid column meta", fmt.Sprintf("The precision of a DECIMAL column must be between 1 and %d, got %d.", maxDecimalPrecision, precision))
+		}
+		if scale := values["scale"]; scale < 0 || scale > precision {
+			resp.Diagnostics.AddAttributeError(metaPath.AtMapKey("scale"), "Invalid column meta", fmt.Sprintf("The scale of a DECIMAL column must be between 0 and its precision %d, got %d.", precision, scale))
+		}
+	}
+}
+
+// keepColumnTypeCase sets the column type of each field to the one in prior
+// when they only differ in case, so a column type configured in lower case is
+// not changed by NEOS returning it in upper case. Fields are matched by name.
+func keepColumnTypeCase(prior []DataProductFieldResourceModel, fields []DataProductFieldResourceModel) {
+	columnTypes := map[string]types.String{}
+	for _, f := range prior {
+		columnTypes[f.Name.ValueString()] = f.DataType.ColumnType
+	}
+	for i, f := range fields {
+		p, ok := columnTypes[f.Name.ValueString()]
+		if ok && !p.IsNull() && !p.IsUnknown() && strings.EqualFold(p.ValueString(), f.DataType.ColumnType.ValueString()) {
+			fields[i].DataType.ColumnType = p
+		}
+	}
+}
@@ -0,0 +1,88 @@
+package provider
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+func TestColumnDataTypeValidator(t *testing.T) {
+	dataType := func(columnType string, meta map[string]string) types.Object {
+		elements := map[string]attr.Value{}
+		for k, v := range meta {
+			elements[k] = types.StringValue(v)
+		}
+		return types.ObjectValueMust(
+			map[string]attr.Type{"column_type": types.StringType, "meta": types.MapType{ElemType: types.StringType}},
+			map[string]attr.Value{"column_type": types.StringValue(columnType), "meta": types.MapValueMust(types.StringType, elements)},
+		)
+	}
+
+	tests := []struct {
+		name       string
+		columnType string
+		meta       map[string]string
+		want       string
+	}{
+		{name: "type without meta", columnType: "STRING", meta: map[string]string{}},
+		{name: "VARCHAR with length", columnType: "VARCHAR", meta: map[string]string{"length": "100"}},
+		{name: "lower case VARCHAR with length", columnType: "varchar", meta: map[string]string{"length": "100"}},
+		{name: "VARCHAR without length", columnType: "VARCHAR", meta: map[string]string{}, want: "Missing column meta"},
+		{name: "lower case VARCHAR without length", columnType: "varchar", meta: map[string]string{}, want: "Missing column meta"},
+		{name: "VARCHAR with zero length", columnType: "VARCHAR", meta: map[string]string{"length": "0"}, want: "Invalid column meta"},
+		{name: "CHAR with length that is not a number", columnType: "CHAR", meta: map[string]string{"length": "ten"}, want: "Invalid column meta"},
+		{name: "DECIMAL", columnType: "Decimal", meta: map[string]string{"precision": "10", "scale": "2"}},
+		{name: "DECIMAL without scale", columnType: "DECIMAL", meta: map[string]string{"precision": "10"}, want: "Missing column meta"},
+		{name: "DECIMAL too precise", columnType: "DECIMAL", meta: map[string]string{"precision": "39", "scale": "2"}, want: "Invalid column meta"},
+		{name: "DECIMAL scale above precision", columnType: "DECIMAL", meta: map[string]string{"precision": "4", "scale": "5"}, want: "Invalid column meta"},
+		{name: "unknown type is left to the column_type validator", columnType: "UUID", meta: map[string]string{}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			req := validator.ObjectRequest{Path: path.Root("data_type"), ConfigValue: dataType(tt.columnType, tt.meta)}
+			resp := &validator.ObjectResponse{}
+			columnDataTypeValidator{}.ValidateObject(context.Background(), req, resp)
+
+			got := []string{}
+			for _, d := range resp.Diagnostics.Errors() {
+				got = append(got, d.Summary())
+			}
+			switch {
+			case tt.want == "" && len(got) > 0:
+				t.Errorf("unexpected errors: %v", resp.Diagnostics)
+			case tt.want != "" && (len(got) == 0 || got[0] != tt.want):
+				t.Errorf("errors = %s, want %q", strings.Join(got, ", "), tt.want)
+			}
+		})
+	}
+}
+
+func TestKeepColumnTypeCase(t *testing.T) {
+	prior := []DataProductFieldResourceModel{
+		testField("id", "int", true, false),
+		testField("name", "varchar", false, false, "length", "50"),
+		testField("total", "decimal", false, true, "precision", "10", "scale", "2"),
+	}
+	fields := []DataProductFieldResourceModel{
+		testField("name", "VARCHAR", false, false, "length", "50"),
+		testField("id", "BIGINT", true, false),
+		testField("total", "Decimal", false, true, "precision", "10", "scale", "2"),
+		testField("added", "STRING", false, true),
+	}
+	keepColumnTypeCase(prior, fields)
+
+	got := []string{}
+	for _, f := range fields {
+		got = append(got, f.Name.ValueString()+" "+f.DataType.ColumnType.ValueString())
+	}
+	want := []string{"name varchar", "id BIGINT", "total decimal", "added STRING"}
+	if strings.Join(got, ", ") != strings.Join(want, ", ") {
+		t.Errorf("column types = %v, want %v", got, want)
+	}
+}
@@ -16,8 +16,9 @@ func NewDataProductDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &dataProductDataSource{}
-	_ datasource.DataSourceWithConfigure = &dataProductDataSource{}
+	_ datasource.DataSource                     = &dataProductDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dataProductDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dataProductDataSource{}
 )
 
 type dataProductDataSource struct {
@@ -26,14 +27,18 @@ type dataProductDataSource struct {
 
 func (d *dataProductDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_data_product"
-	//resp.TypeName = "neos_data_product"
 }
 
 func (d *dataProductDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
-	tflog.Info(ctx, "Abi READ")
+	tflog.Info(ctx, "dataProductDataSource READ")
 
-	var state DataProductDataSourceModelV2
+	var config DataProductModelV2
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.Get()
 	if err != nil {
@@ -44,26 +49,18 @@ func (d *dataProductDataSource) Read(ctx context.Context, req datasource.ReadReq
 		return
 	}
 
-	tflog.Info(ctx, "Abi READ Post error ")
-
-	tflog.Info(ctx, fmt.Sprintf("Abi READ length %d", len(list.Entities)))
-
-	// Map response body to model
-	for _, ds := range list.Entities {
-		dataProductStateV2 := DataProductModelV2{
-			Identifier:  types.StringValue(ds.Identifier),
-			Name:        types.StringValue(ds.Name),
-			Description: types.StringValue(ds.Description),
-			Label:       types.StringValue(ds.Label),
-			Owner:       types.StringValue(ds.Owner),
-			Urn:         types.StringValue(ds.Urn),
-		}
-		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Identifier))
-		state.DataProducts = append(state.DataProducts, dataProductStateV2)
+	lookup := entityLookup{ID: config.Identifier, Name: config.Name, URN: config.Urn}
+	matches := filterEntities(list.Entities, func(e neos.DataProduct) bool {
+		return lookup.matches(e.Identifier, e.Name, e.Urn)
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "data product", lookup.String(), len(matches)) {
+		return
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
+	state := newDataProductModelV2(matches[0])
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -71,17 +68,16 @@ func (d *dataProductDataSource) Read(ctx context.Context, req datasource.ReadReq
 
 }
 
-// Configure adds the provider configured client to the data source.
 func (d *dataProductDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	tflog.Info(ctx, "Data source configure")
+	tflog.Info(ctx, "dataProductDataSource Data source configure")
 
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected dataProductDataSource Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected dataProductDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 
 		return
 	}
@@ -89,57 +85,19 @@ func (d *dataProductDataSource) Configure(ctx context.Context, req datasource.Co
 	d.client = &client.DataProductClient
 }
 
+func (d *dataProductDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
+}
+
 func (d *dataProductDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := catalogueEntityDataSourceAttributes()
+	setLookupAttributes(attributes, "data product", "id", "name", "urn")
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"datasystems": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"label": schema.StringAttribute{
-							Computed: true,
-						},
-						"created_at": schema.StringAttribute{
-							Computed: true,
-						},
-						"owner": schema.StringAttribute{
-							Computed: true,
-						},
-						"state": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"state": schema.StringAttribute{
-									Computed: true,
-								},
-								"healthy": schema.BoolAttribute{
-									Computed: true,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type DataProductDataSourceModelV2 struct {
-	DataProducts []DataProductModelV2 `tfsdk:"datasystems"`
-}
-
-// coffeesModel maps coffees schema data.
 type DataProductModelV2 struct {
 	Identifier  types.String            `tfsdk:"id"`
 	Urn         types.String            `tfsdk:"urn"`
@@ -155,3 +113,19 @@ type DataProductStateModelV2 struct {
 	State   types.String `tfsdk:"state"`
 	Healthy types.Bool   `tfsdk:"healthy"`
 }
+
+func newDataProductModelV2(e neos.DataProduct) DataProductModelV2 {
+	return DataProductModelV2{
+		Identifier:  types.StringValue(e.Identifier),
+		Name:        types.StringValue(e.Name),
+		Description: types.StringValue(e.Description),
+		Label:       types.StringValue(e.Label),
+		Owner:       types.StringValue(e.Owner),
+		Urn:         types.StringValue(e.Urn),
+		CreatedAt:   types.StringValue(e.CreatedAt.String()),
+		State: DataProductStateModelV2{
+			State:   types.StringValue(e.State.State),
+			Healthy: types.BoolValue(e.State.Healthy),
+		},
+	}
+}
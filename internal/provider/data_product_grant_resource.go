@@ -0,0 +1,386 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+	"golang.org/x/exp/slices"
+)
+
+// dataProductGrantActions are the policy actions each grant permission maps to.
+var dataProductGrantActions = map[string][]string{
+	"read":  {"product:browse", "product:consume"},
+	"write": {"product:browse", "product:consume", "product:update"},
+	"admin": {"product:*"},
+}
+
+var dataProductGrantPermissions = []string{"read", "write", "admin"}
+
+// NewDataProductGrantResource is a helper function to simplify the provider implementation.
+func NewDataProductGrantResource() resource.Resource {
+	return &dataProductGrantResource{}
+}
+
+// dataProductGrantResource manages a single statement in the policy of a
+// principal, the other statements of the policy are left alone.
+type dataProductGrantResource struct {
+	client            *neos.PolicyClient
+	dataProductClient *neos.DataProductClient
+	providerAccount   string
+}
+
+var (
+	_ resource.Resource                = &dataProductGrantResource{}
+	_ resource.ResourceWithConfigure   = &dataProductGrantResource{}
+	_ resource.ResourceWithModifyPlan  = &dataProductGrantResource{}
+	_ resource.ResourceWithImportState = &dataProductGrantResource{}
+)
+
+// Metadata returns the resource type name.
+func (r *dataProductGrantResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_product_grant"
+}
+
+// Schema defines the schema for the resource.
+func (r *dataProductGrantResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The ID of the grant in the form <principal>/<data_product_id>",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"principal": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The ID of the user or group the access is granted to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"data_product_id": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The ID of the data product",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The permission level, one of read, write or admin",
+				Validators: []validator.String{
+					stringvalidator.OneOf(dataProductGrantPermissions...),
+				},
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
+			},
+			"statement_sid": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The sid of the policy statement managed by the grant",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// dataProductGrantResourceModel maps the resource schema data.
+type dataProductGrantResourceModel struct {
+	ID            types.String `tfsdk:"id"`
+	Principal     types.String `tfsdk:"principal"`
+	DataProductID types.String `tfsdk:"data_product_id"`
+	Permission    types.String `tfsdk:"permission"`
+	Account       types.String `tfsdk:"account"`
+	StatementSid  types.String `tfsdk:"statement_sid"`
+	LastUpdated   types.String `tfsdk:"last_updated"`
+}
+
+// dataProductGrantSid is the sid of the statement a grant manages.
+func dataProductGrantSid(dataProductID string) string {
+	return dataProductGrantSidPrefix + dataProductID
+}
+
+// Create a new resource.
+func (r *dataProductGrantResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataProductGrantResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.putStatement(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating data product grant", "Could not grant access to data product, unexpected error: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.Principal.ValueString(), plan.DataProductID.ValueString()))
+	plan.StatementSid = types.StringValue(dataProductGrantSid(plan.DataProductID.ValueString()))
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *dataProductGrantResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataProductGrantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+
+	doc, found, err := r.principalPolicy(state.Principal.ValueString(), state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading NEOS data product grant", "Could not read NEOS policy of principal "+state.Principal.ValueString()+": "+err.Error())
+		return
+	}
+
+	sid := dataProductGrantSid(state.DataProductID.ValueString())
+	idx := -1
+	if found {
+		idx = slices.IndexFunc(doc.Policy.Statements, func(s policyStatement) bool { return s.Sid == sid })
+	}
+	if idx < 0 {
+		tflog.Info(ctx, fmt.Sprintf("statement %s is no longer in the policy of %s", sid, state.Principal.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// A statement edited outside Terraform no longer matches a permission, an
+	// empty permission makes the next plan put it back.
+	state.Permission = types.StringValue("")
+	for _, p := range dataProductGrantPermissions {
+		if slices.Equal(doc.Policy.Statements[idx].Action, dataProductGrantActions[p]) && strings.EqualFold(doc.Policy.Statements[idx].Effect, "allow") {
+			state.Permission = types.StringValue(p)
+		}
+	}
+	state.StatementSid = types.StringValue(sid)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *dataProductGrantResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan dataProductGrantResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.putStatement(ctx, plan)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating data product grant", "Could not update access to data product, unexpected error: "+err.Error())
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the statement of the grant from the policy of the principal.
+func (r *dataProductGrantResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataProductGrantResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	defer lockPrincipalPolicy(state.Account.ValueString(), state.Principal.ValueString())()
+
+	doc, found, err := r.principalPolicy(state.Principal.ValueString(), state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting data product grant", "Could not read NEOS policy of principal "+state.Principal.ValueString()+": "+err.Error())
+		return
+	}
+	if !found {
+		return
+	}
+
+	sid := dataProductGrantSid(state.DataProductID.ValueString())
+	statements := []policyStatement{}
+	for _, s := range doc.Policy.Statements {
+		if s.Sid != sid {
+			statements = append(statements, s)
+		}
+	}
+	doc.Policy.Statements = statements
+
+	j, err := doc.json()
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting data product grant", err.Error())
+		return
+	}
+
+	_, err = r.client.Put(ctx, state.Principal.ValueString(), neos.PolicyPutRequest{Policy: j}, state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting data product grant", "Could not remove statement from policy, unexpected error: "+err.Error())
+		return
+	}
+}
+
+// principalPolicy returns the policy of the principal, found is false when
+// the principal has no policy yet.
+func (r *dataProductGrantResource) principalPolicy(principal string, account string) (policyDocument, bool, error) {
+	policy, err := r.client.Get(principal, account)
+	if isNotFoundError(err) {
+		return policyDocument{}, false, nil
+	}
+	if err != nil {
+		return policyDocument{}, false, err
+	}
+	doc, err := parsePolicyDocument(policy.Policy)
+	return doc, err == nil, err
+}
+
+// putStatement replaces the statement of the grant in the policy of the
+// principal, creating the policy when the principal has none. The policy is
+// locked so grants to the same principal do not drop each other's statements.
+func (r *dataProductGrantResource) putStatement(ctx context.Context, plan dataProductGrantResourceModel) error {
+	list, err := r.dataProductClient.Get()
+	if err != nil {
+		return err
+	}
+	idx := slices.IndexFunc(list.Entities, func(e neos.DataProduct) bool { return e.Identifier == plan.DataProductID.ValueString() })
+	if idx < 0 {
+		return fmt.Errorf("data product %s not found", plan.DataProductID.ValueString())
+	}
+
+	principal := plan.Principal.ValueString()
+	defer lockPrincipalPolicy(plan.Account.ValueString(), principal)()
+
+	doc, found, err := r.principalPolicy(principal, plan.Account.ValueString())
+	if err != nil {
+		return err
+	}
+	if !found {
+		doc = policyDocument{User: principal, Policy: policyBody{Version: policyDocumentVersion, Statements: []policyStatement{}}}
+	}
+
+	doc.mergeStatements([]policyStatement{{
+		Sid:       dataProductGrantSid(plan.DataProductID.ValueString()),
+		Principal: principal,
+		Action:    slices.Clone(dataProductGrantActions[plan.Permission.ValueString()]),
+		Resource:  []string{list.Entities[idx].Urn},
+		Condition: []string{},
+		Effect:    "allow",
+	}})
+
+	j, err := doc.json()
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("granting %s on data product %s to %s", plan.Permission.ValueString(), plan.DataProductID.ValueString(), principal))
+	if found {
+		_, err = r.client.Put(ctx, principal, neos.PolicyPutRequest{Policy: j}, plan.Account.ValueString())
+		return err
+	}
+	_, err = r.client.Post(ctx, neos.PolicyPostRequest{Policy: j}, plan.Account.ValueString())
+	return err
+}
+
+func (r *dataProductGrantResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Product Grant Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = &client.PolicyClient
+	r.dataProductClient = &client.DataProductClient
+	r.providerAccount = client.Account
+}
+
+// ModifyPlan defaults the account to the provider's.
+func (r *dataProductGrantResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
+}
+
+// ImportState imports a grant from <principal>/<data_product_id> or
+// <account>/<principal>/<data_product_id>, the principal and data product may
+// be given as NEOS URNs.
+func (r *dataProductGrantResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids, account, err := parseImportIDParts(req.ID, "<principal>/<data_product_id>", 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	principal, dataProductID := ids[0].ID, ids[1].ID
+
+	// The id in the URN of a data product is not its identifier.
+	if ids[1].URN != "" {
+		list, err := r.dataProductClient.Get()
+		if err != nil {
+			resp.Diagnostics.AddError("Error importing resource", "Could not resolve URN "+ids[1].URN+", unexpected error: "+err.Error())
+			return
+		}
+		id, found := findByURN(list.Entities, ids[1].URN, func(e neos.DataProduct) string { return e.Urn }, func(e neos.DataProduct) string { return e.Identifier })
+		if !found {
+			resp.Diagnostics.AddError("Error importing resource", fmt.Sprintf("No entity with URN %q was found.", ids[1].URN))
+			return
+		}
+		dataProductID = id
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", principal, dataProductID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal"), principal)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("data_product_id"), dataProductID)...)
+	if account != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), account)...)
+	}
+}
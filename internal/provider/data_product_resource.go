@@ -22,13 +22,16 @@ func NewDataProductResource() resource.Resource {
 // dataProductResource is the resource implementation.
 type dataProductResource struct {
 	client       *neos.DataProductClient
+	linksClient  *neos.LinksClient
 	schemaClient *neos.DataProductSchemaClient
+	apiClient    *neosAPIClient
 }
 
 var (
 	_ resource.Resource                = &dataProductResource{}
 	_ resource.ResourceWithConfigure   = &dataProductResource{}
 	_ resource.ResourceWithImportState = &dataProductResource{}
+	_ resource.ResourceWithModifyPlan  = &dataProductResource{}
 )
 
 // Metadata returns the resource type name.
@@ -105,14 +108,23 @@ func (r *dataProductResource) Schema(_ context.Context, _ resource.SchemaRequest
 				Required:    true,
 				Description: "list of links",
 			},
+			"allow_breaking_schema_changes": schema.BoolAttribute{
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "When true schema changes that break existing consumers, such as removing a column, changing the primary key or narrowing a column type, are applied with a warning rather than refused",
+			},
+			"deletion_protection": deletionProtectionAttribute("data product"),
+			"force_destroy":       forceDestroyAttribute("data product"),
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
 
 			"schema": schema.SingleNestedAttribute{
-				Computed: false,
-				Optional: true,
-				Required: false,
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "The schema of the data product, leave it out when the schema is managed with neos_data_product_schema",
 				Attributes: map[string]schema.Attribute{
 					"product_type": schema.StringAttribute{
 						Computed:    false,
@@ -125,52 +137,7 @@ func (r *dataProductResource) Schema(_ context.Context, _ resource.SchemaRequest
 						Optional: true,
 						Required: false,
 						NestedObject: schema.NestedAttributeObject{
-							Attributes: map[string]schema.Attribute{
-								"name": schema.StringAttribute{
-									Computed:    false,
-									Required:    false,
-									Optional:    true,
-									Description: "Name of the schema field",
-								},
-								"description": schema.StringAttribute{
-									Computed:    false,
-									Optional:    false,
-									Required:    true,
-									Description: "Description of the schema field",
-								},
-								"primary": schema.BoolAttribute{
-									Computed:    false,
-									Optional:    true,
-									Required:    false,
-									Description: "set the schmea field to be a primary key",
-								},
-								"optional": schema.BoolAttribute{
-									Computed:    false,
-									Optional:    true,
-									Required:    false,
-									Description: "set the schmea field to be a optional",
-								},
-								"data_type": schema.SingleNestedAttribute{
-									Computed: false,
-									Optional: true,
-									Required: false,
-									Attributes: map[string]schema.Attribute{
-										"column_type": schema.StringAttribute{
-											Computed:    false,
-											Optional:    true,
-											Required:    false,
-											Description: "set the schmea field column type",
-										},
-										"meta": schema.MapAttribute{
-											ElementType: types.StringType,
-											Computed:    false,
-											Optional:    true,
-											Required:    false,
-										},
-									},
-									Description: "set the schmea field data type",
-								},
-							},
+							Attributes: dataProductSchemaFieldAttributes(),
 						},
 					},
 				},
@@ -181,17 +148,20 @@ func (r *dataProductResource) Schema(_ context.Context, _ resource.SchemaRequest
 
 // dataProductResourceModel maps the resource schema data.
 type dataProductResourceModel struct {
-	ID          types.String           `tfsdk:"id"`
-	URN         types.String           `tfsdk:"urn"`
-	Name        types.String           `tfsdk:"name"`
-	Label       types.String           `tfsdk:"label"`
-	Description types.String           `tfsdk:"description"`
-	Owner       types.String           `tfsdk:"owner"`
-	CreatedAt   types.String           `tfsdk:"created_at"`
-	Links       types.List             `tfsdk:"links"`
-	ContactIds  types.List             `tfsdk:"contact_ids"`
-	LastUpdated types.String           `tfsdk:"last_updated"`
-	Schema      DataProductSchemaModel `tfsdk:"schema"`
+	ID                 types.String            `tfsdk:"id"`
+	URN                types.String            `tfsdk:"urn"`
+	Name               types.String            `tfsdk:"name"`
+	Label              types.String            `tfsdk:"label"`
+	Description        types.String            `tfsdk:"description"`
+	Owner              types.String            `tfsdk:"owner"`
+	CreatedAt          types.String            `tfsdk:"created_at"`
+	Links              types.List              `tfsdk:"links"`
+	ContactIds         types.List              `tfsdk:"contact_ids"`
+	AllowBreaking      types.Bool              `tfsdk:"allow_breaking_schema_changes"`
+	DeletionProtection types.Bool              `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool              `tfsdk:"force_destroy"`
+	LastUpdated        types.String            `tfsdk:"last_updated"`
+	Schema             *DataProductSchemaModel `tfsdk:"schema"`
 }
 
 type DataProductSchemaModel struct {
@@ -265,6 +235,18 @@ func (r *dataProductResource) Create(ctx context.Context, req resource.CreateReq
 		},
 	}
 
+	// The schema request is built first so a bad one does not leave a product
+	// without its schema.
+	putSchema := plan.Schema != nil && plan.Schema.ProductType.ValueString() != "" && len(plan.Schema.Fields) != 0
+	var schemaPutRequest neos.DataProductSchemaPutRequest
+	if putSchema {
+		schemaPutRequest, diags = newDataProductSchemaPutRequest(ctx, plan.Schema.ProductType.ValueString(), plan.Schema.Fields)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
 	tflog.Info(ctx, "dataProductResource Create date product post")
 	result, err := r.client.Post(ctx, item)
 	if err != nil {
@@ -275,81 +257,30 @@ func (r *dataProductResource) Create(ctx context.Context, req resource.CreateReq
 	id := result.Identifier
 	tflog.Info(ctx, fmt.Sprintf("dataProductResource Create date product post id %s", id))
 
-	fields := []neos.DataProductSchemaFieldPutRequest{}
-
-	for _, v := range plan.Schema.Fields {
-
-		meta := make(map[string]string)
-		diag := v.DataType.Meta.ElementsAs(ctx, &meta, true)
-		if diag.HasError() {
-			tflog.Info(ctx, fmt.Sprintf("%v", diag.Errors()))
-			resp.Diagnostics.AddError("Error creating v.DataType.Meta.ToMapValue", "Error")
-			return
-		}
-
-		dataType := neos.DataProductSchemaDataTypePutRequest{
-			Meta:       meta,
-			ColumnType: v.DataType.ColumnType.ValueString(),
-		}
-
-		f := neos.DataProductSchemaFieldPutRequest{
-			Description: v.Description.ValueString(),
-			Name:        v.Name.ValueString(),
-			Primary:     v.Primary.ValueBool(),
-			Optional:    v.Optional.ValueBool(),
-			DataType:    dataType,
-		}
-		fields = append(fields, f)
+	// The id is only known now, a neos_data_product_schema of the new product
+	// is planned again after this.
+	if plan.Schema != nil {
+		claimDataProductSchema(&resp.Diagnostics, path.Root("schema"), id, "neos_data_product")
 	}
 
-	if plan.Schema.ProductType.ValueString() != "" && len(fields) != 0 {
-		schemaPutRequest := neos.DataProductSchemaPutRequest{
-			Details: neos.DataProductSchemaDetailsPutRequest{
-				ProductType: plan.Schema.ProductType.ValueString(),
-				Fields:      fields,
-			},
-		}
-
+	if putSchema {
 		tflog.Info(ctx, fmt.Sprintf("dataProductResource Create schema put %s", id))
 		schemaResult, err := r.schemaClient.Put(ctx, id, schemaPutRequest)
 		if err != nil {
 			resp.Diagnostics.AddError("Error putting data product schema ", "Could not create data product schema, unexpected error: "+err.Error())
+			r.deleteHalfCreated(ctx, id, resp)
 			return
 		}
 
-		pfields := []DataProductFieldResourceModel{}
-
-		for _, v := range schemaResult.Fields {
-
-			tflog.Info(ctx, "dataProductResource into schemaResult.Fields")
-			tflog.Info(ctx, fmt.Sprintf("dataProductResource ColumnType values [%s] ", types.StringValue(v.DataType.ColumnType)))
-
-			meta, diag := types.MapValueFrom(ctx, types.StringType, v.DataType.Meta)
-			resp.Diagnostics.Append(diag...)
-			if resp.Diagnostics.HasError() {
-				resp.Diagnostics.AddError("ErrorMapping values for datatype meta ", "Could not create data product schema, unexpected error: "+err.Error())
-				return
-			}
-
-			dt := DataProductDataTypeResourceModel{
-				Meta:       meta,
-				ColumnType: types.StringValue(v.DataType.ColumnType),
-			}
-
-			tflog.Info(ctx, fmt.Sprintf("dataProductResource field values [%s] [%v] [%v] [%s] ", types.StringValue(v.Name), types.BoolValue(v.Primary), types.BoolValue(v.Optional), types.StringValue(v.Description)))
-
-			i := DataProductFieldResourceModel{
-				Name:        types.StringValue(v.Name),
-				Primary:     types.BoolValue(v.Primary),
-				Optional:    types.BoolValue(v.Optional),
-				Description: types.StringValue(v.Description),
-				DataType:    dt,
-			}
-			pfields = append(pfields, i)
+		fields, diags := newDataProductFieldModels(ctx, dataProductSchemaPutResponseFields(schemaResult))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-		plan.Schema = DataProductSchemaModel{
+		keepColumnTypeCase(plan.Schema.Fields, fields)
+		plan.Schema = &DataProductSchemaModel{
 			ProductType: types.StringValue(schemaPutRequest.Details.ProductType),
-			Fields:      pfields,
+			Fields:      fields,
 		}
 	}
 
@@ -369,6 +300,20 @@ func (r *dataProductResource) Create(ctx context.Context, req resource.CreateReq
 
 }
 
+// deleteHalfCreated deletes a data product whose schema could not be set, so a
+// failed create does not leave a product behind that Terraform does not know
+// about. When the delete fails too the product is saved to the state, and is
+// replaced by the next apply.
+func (r *dataProductResource) deleteHalfCreated(ctx context.Context, id string, resp *resource.CreateResponse) {
+	err := r.client.Delete(ctx, id)
+	if err == nil {
+		return
+	}
+
+	resp.Diagnostics.AddError("Error deleting data product", "Could not delete the data product "+id+" created without its schema, unexpected error: "+err.Error())
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
+
 // Read refreshes the Terraform state with the latest data.
 // Read resource information.
 func (r *dataProductResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -400,24 +345,43 @@ func (r *dataProductResource) Read(ctx context.Context, req resource.ReadRequest
 			state.Label = types.StringValue(ds.Label)
 			state.URN = types.StringValue(ds.Urn)
 			state.Description = types.StringValue(ds.Description)
-			state.Owner = types.StringValue(ds.Owner)
 			state.CreatedAt = types.StringValue(ds.CreatedAt.String())
 
-			dataProductSchema, err := r.schemaClient.Get(ds.Identifier)
-			if err != nil {
-				// no schema so assume its not be created rather than an error
-				dataProductSchema = neos.DataProductSchema{}
-				//resp.Diagnostics.AddError("Error Reading NEOS data product", "Could not read NEOS schema data product ID "+state.ID.ValueString()+": "+err.Error())
-				//return
+			// The schema is left to neos_data_product_schema when it is not set.
+			if state.Schema != nil {
+				dataProductSchema, err := r.schemaClient.Get(ds.Identifier)
+				if isNotFoundError(err) {
+					// no schema so assume its not be created rather than an error
+					dataProductSchema = neos.DataProductSchema{}
+				} else if err != nil {
+					// An empty schema would let the next breaking change through as a first one.
+					resp.Diagnostics.AddError("Error Reading NEOS data product schema", "Could not read NEOS data product schema ID "+ds.Identifier+": "+err.Error())
+					return
+				}
+				fields, diags := newDataProductFieldModels(ctx, dataProductSchema.Fields)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				keepColumnTypeCase(state.Schema.Fields, fields)
+				state.Schema.Fields = fields
+			} else if importing, _ := req.Private.GetKey(ctx, dataProductImportSchemaKey); importing != nil {
+				state.Schema, diags = readDataProductSchema(ctx, r.apiClient, ds.Identifier)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+				resp.Diagnostics.Append(resp.Private.SetKey(ctx, dataProductImportSchemaKey, nil)...)
 			}
-			dpsm, shouldReturn := convertSchemaToModel(ctx, dataProductSchema, resp)
-			if shouldReturn {
+
+			info, diags := readEntityInfo(ctx, r.apiClient, "data_product", ds.Identifier)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
 				return
 			}
-
-			state.Schema = dpsm
-			//state.Schema.ProductType = types.StringValue("stored")
-
+			state.Owner = info.Owner
+			state.ContactIds = info.ContactIds
+			state.Links = info.Links
 			break
 		}
 	}
@@ -431,33 +395,64 @@ func (r *dataProductResource) Read(ctx context.Context, req resource.ReadRequest
 
 }
 
-func convertSchemaToModel(ctx context.Context, dataProductSchema neos.DataProductSchema, resp *resource.ReadResponse) (DataProductSchemaModel, bool) {
-	fields := []DataProductFieldResourceModel{}
-	for _, v := range dataProductSchema.Fields {
-		meta, diag := types.MapValueFrom(ctx, types.StringType, v.DataType.Meta)
-		resp.Diagnostics.Append(diag...)
+// dataProductImportSchemaKey marks an imported data product in the private
+// state so that its first Read also reads the schema.
+const dataProductImportSchemaKey = "import_schema"
+
+// ModifyPlan classifies the schema changes as additive, widening or breaking
+// and refuses breaking ones unless allow_breaking_schema_changes is set. It
+// waits for the apply when the schema is not fully known.
+func (r *dataProductResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var planSchema types.Object
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schema"), &planSchema)...)
+	if resp.Diagnostics.HasError() || planSchema.IsNull() {
+		return
+	}
+
+	var planID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("id"), &planID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !planID.IsUnknown() {
+		claimDataProductSchema(&resp.Diagnostics, path.Root("schema"), planID.ValueString(), "neos_data_product")
+	}
+	if req.State.Raw.IsNull() {
+		return
+	}
+	raw, err := planSchema.ToTerraformValue(ctx)
+	if err != nil || !raw.IsFullyKnown() {
+		return
+	}
+
+	var allowBreaking types.Bool
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("allow_breaking_schema_changes"), &allowBreaking)...)
+
+	var planModel, stateModel *DataProductSchemaModel
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("schema"), &planModel)...)
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("schema"), &stateModel)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if stateModel == nil {
+		var id types.String
+		resp.Diagnostics.Append(req.State.GetAttribute(ctx, path.Root("id"), &id)...)
 		if resp.Diagnostics.HasError() {
-			resp.Diagnostics.AddError("Error Mapping values for datatype meta ", "unexpected error")
-			return DataProductSchemaModel{}, true
+			return
 		}
-
-		field := DataProductFieldResourceModel{
-			Name:        types.StringValue(v.Name),
-			Description: types.StringValue(v.Description),
-			Primary:     types.BoolValue(v.Primary),
-			Optional:    types.BoolValue(v.Optional),
-			DataType: DataProductDataTypeResourceModel{
-				ColumnType: types.StringValue(v.DataType.ColumnType),
-				Meta:       meta,
-			},
+		current, diags := readDataProductSchema(ctx, r.apiClient, id.ValueString())
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() || current == nil {
+			return
 		}
-		fields = append(fields, field)
+		stateModel = current
 	}
 
-	dpsm := DataProductSchemaModel{
-		Fields: fields,
-	}
-	return dpsm, false
+	checkPlannedSchemaChanges(ctx, &resp.Diagnostics, path.Root("schema"), *stateModel, *planModel, allowBreaking.ValueBool())
 }
 
 // Update updates the resource and sets the updated Terraform state on success.
@@ -472,6 +467,28 @@ func (r *dataProductResource) Update(ctx context.Context, req resource.UpdateReq
 		return
 	}
 
+	var state dataProductResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The schema may only have been known after the plan was made.
+	if plan.Schema != nil {
+		current := state.Schema
+		if current == nil {
+			current, diags = readDataProductSchema(ctx, r.apiClient, state.ID.ValueString())
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+		if current != nil && !checkPlannedSchemaChanges(ctx, &resp.Diagnostics, path.Root("schema"), *current, *plan.Schema, plan.AllowBreaking.ValueBool()) {
+			return
+		}
+	}
+
 	linkList, diag := plan.Links.ToListValue(ctx)
 	resp.Diagnostics.Append(diag...)
 	if resp.Diagnostics.HasError() {
@@ -550,43 +567,13 @@ func (r *dataProductResource) Update(ctx context.Context, req resource.UpdateReq
 	id := result.Identifier
 
 	tflog.Info(ctx, fmt.Sprintf("dataProductResource Update date product post id %s", id))
-	fields := []neos.DataProductSchemaFieldPutRequest{}
-
-	for _, v := range plan.Schema.Fields {
-
-		meta := make(map[string]string)
-
-		diag := v.DataType.Meta.ElementsAs(ctx, &meta, true)
-		if diag.HasError() {
-			tflog.Info(ctx, fmt.Sprintf("%v", diag.Errors()))
-			resp.Diagnostics.AddError("Error creating v.DataType.Meta.ToMapValue", "Error")
+	if plan.Schema != nil && plan.Schema.ProductType.ValueString() != "" && len(plan.Schema.Fields) != 0 {
+		schemaPutRequest, diags := newDataProductSchemaPutRequest(ctx, plan.Schema.ProductType.ValueString(), plan.Schema.Fields)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
 
-		dataType := neos.DataProductSchemaDataTypePutRequest{
-			Meta:       meta,
-			ColumnType: v.DataType.ColumnType.ValueString(),
-		}
-
-		f := neos.DataProductSchemaFieldPutRequest{
-			Description: v.Description.ValueString(),
-			Name:        v.Name.ValueString(),
-			Primary:     v.Primary.ValueBool(),
-			Optional:    v.Optional.ValueBool(),
-			DataType:    dataType,
-		}
-		fields = append(fields, f)
-	}
-
-	schemaPutRequest := neos.DataProductSchemaPutRequest{
-		Details: neos.DataProductSchemaDetailsPutRequest{
-			ProductType: plan.Schema.ProductType.ValueString(),
-			Fields:      fields,
-		},
-	}
-
-	if plan.Schema.ProductType.ValueString() != "" && len(fields) != 0 {
-
 		tflog.Info(ctx, fmt.Sprintf("dataProductResource update schema put %s", id))
 		schemaResult, err := r.schemaClient.Put(ctx, id, schemaPutRequest)
 		if err != nil {
@@ -597,40 +584,15 @@ func (r *dataProductResource) Update(ctx context.Context, req resource.UpdateReq
 			return
 		}
 
-		pfields := []DataProductFieldResourceModel{}
-
-		for _, v := range schemaResult.Fields {
-			tflog.Info(ctx, fmt.Sprintf("dataProductResource ColumnType values [%s] ", types.StringValue(v.DataType.ColumnType)))
-			meta, diag := types.MapValueFrom(ctx, types.StringType, v.DataType.Meta)
-			resp.Diagnostics.Append(diag...)
-			if resp.Diagnostics.HasError() {
-				resp.Diagnostics.AddError(
-					"ErrorMapping values for datatype meta ",
-					"Could not update data product schema, unexpected error: "+err.Error(),
-				)
-				return
-			}
-
-			dt := DataProductDataTypeResourceModel{
-				Meta:       meta,
-				ColumnType: types.StringValue(v.DataType.ColumnType),
-			}
-
-			tflog.Info(ctx, fmt.Sprintf("dataProductResource field values [%s] [%v] [%v] [%s]", types.StringValue(v.Name), types.BoolValue(v.Primary), types.BoolValue(v.Optional), types.StringValue(v.Description)))
-
-			i := DataProductFieldResourceModel{
-				Name:        types.StringValue(v.Name),
-				Primary:     types.BoolValue(v.Primary),
-				Optional:    types.BoolValue(v.Optional),
-				Description: types.StringValue(v.Description),
-				DataType:    dt,
-			}
-			pfields = append(pfields, i)
+		fields, diags := newDataProductFieldModels(ctx, dataProductSchemaPutResponseFields(schemaResult))
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
 		}
-
-		plan.Schema = DataProductSchemaModel{
+		keepColumnTypeCase(plan.Schema.Fields, fields)
+		plan.Schema = &DataProductSchemaModel{
 			ProductType: types.StringValue(schemaPutRequest.Details.ProductType),
-			Fields:      pfields,
+			Fields:      fields,
 		}
 	}
 	diags = resp.State.Set(ctx, plan)
@@ -653,6 +615,18 @@ func (r *dataProductResource) Delete(ctx context.Context, req resource.DeleteReq
 
 	tflog.Info(ctx, fmt.Sprintf("DP Delete iterate plan ID: %s", id))
 
+	if !checkDeletionProtection(&resp.Diagnostics, plan.DeletionProtection, "data product", plan.Name.ValueString()) {
+		return
+	}
+
+	if plan.ForceDestroy.ValueBool() {
+		err := deleteEntityLinks(ctx, r.linksClient, id)
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting data product", "Could not remove the links of the data product, unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	err := r.client.Delete(ctx, id)
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting data product", "Could not delete data product, unexpected error: "+err.Error())
@@ -666,7 +640,7 @@ func (r *dataProductResource) Configure(_ context.Context, req resource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.DataProductClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -674,6 +648,8 @@ func (r *dataProductResource) Configure(_ context.Context, req resource.Configur
 	}
 
 	r.client = &client.DataProductClient
+	r.linksClient = &client.LinksClient
+	r.apiClient = client.API
 
 	// schemaClient, ok := req.ProviderData.(*neos.DataProductSchemaClient)
 
@@ -685,7 +661,17 @@ func (r *dataProductResource) Configure(_ context.Context, req resource.Configur
 	r.schemaClient = &client.DataProductSchemaClient
 }
 
+// ImportState imports the resource by its id, NEOS URN or name:<name>, the
+// schema is read with it.
 func (r *dataProductResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importCatalogueEntity(ctx, req, resp, "data product", func() ([]neos.DataProduct, error) {
+		list, err := r.client.Get()
+		return list.Entities, err
+	}, func(e neos.DataProduct) (string, string, string) {
+		return e.Identifier, e.Name, e.Urn
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.Private.SetKey(ctx, dataProductImportSchemaKey, []byte("true"))...)
 }
@@ -0,0 +1,219 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+// dataProductSchemaFieldAttributes are the attributes of a field of a data
+// product schema, shared by neos_data_product and neos_data_product_schema.
+func dataProductSchemaFieldAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed:    false,
+			Required:    false,
+			Optional:    true,
+			Description: "Name of the schema field",
+		},
+		"description": schema.StringAttribute{
+			Computed:    false,
+			Optional:    false,
+			Required:    true,
+			Description: "Description of the schema field",
+		},
+		"primary": schema.BoolAttribute{
+			Computed:    false,
+			Optional:    true,
+			Required:    false,
+			Description: "set the schmea field to be a primary key",
+		},
+		"optional": schema.BoolAttribute{
+			Computed:    false,
+			Optional:    true,
+			Required:    false,
+			Description: "set the schmea field to be a optional",
+		},
+		"data_type": schema.SingleNestedAttribute{
+			Computed: false,
+			Optional: true,
+			Required: false,
+			Attributes: map[string]schema.Attribute{
+				"column_type": schema.StringAttribute{
+					Computed:    false,
+					Optional:    true,
+					Required:    false,
+					Description: "set the schmea field column type, one of " + strings.Join(neosColumnTypeNames(), ", "),
+					Validators: []validator.String{
+						stringvalidator.OneOfCaseInsensitive(neosColumnTypeNames()...),
+					},
+				},
+				"meta": schema.MapAttribute{
+					ElementType: types.StringType,
+					Computed:    false,
+					Optional:    true,
+					Required:    false,
+					Description: "settings of the column type, length for CHAR and VARCHAR, precision and scale for DECIMAL",
+				},
+			},
+			Description: "set the schmea field data type",
+			Validators: []validator.Object{
+				columnDataTypeValidator{},
+			},
+		},
+	}
+}
+
+// newDataProductSchemaPutRequest builds the request that sets the schema of a
+// data product.
+func newDataProductSchemaPutRequest(ctx context.Context, productType string, fields []DataProductFieldResourceModel) (neos.DataProductSchemaPutRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rtn := neos.DataProductSchemaPutRequest{
+		Details: neos.DataProductSchemaDetailsPutRequest{
+			ProductType: productType,
+			Fields:      []neos.DataProductSchemaFieldPutRequest{},
+		},
+	}
+
+	for _, v := range fields {
+		meta := make(map[string]string)
+		diags.Append(v.DataType.Meta.ElementsAs(ctx, &meta, true)...)
+		if diags.HasError() {
+			return rtn, diags
+		}
+
+		rtn.Details.Fields = append(rtn.Details.Fields, neos.DataProductSchemaFieldPutRequest{
+			Description: v.Description.ValueString(),
+			Name:        v.Name.ValueString(),
+			Primary:     v.Primary.ValueBool(),
+			Optional:    v.Optional.ValueBool(),
+			DataType: neos.DataProductSchemaDataTypePutRequest{
+				Meta:       meta,
+				ColumnType: v.DataType.ColumnType.ValueString(),
+			},
+		})
+	}
+	return rtn, diags
+}
+
+// dataProductSchemaPutResponseFields is the schema the put returned as the
+// fields a get returns.
+func dataProductSchemaPutResponseFields(result neos.DataProductSchemaPutResponse) []neos.DataProductSchemaField {
+	rtn := []neos.DataProductSchemaField{}
+	for _, v := range result.Fields {
+		rtn = append(rtn, neos.DataProductSchemaField{
+			Name:        v.Name,
+			Description: v.Description,
+			Primary:     v.Primary,
+			Optional:    v.Optional,
+			DataType:    neos.DataProductSchemaDataType(v.DataType),
+		})
+	}
+	return rtn
+}
+
+// newDataProductFieldModels maps the fields of a data product schema to the
+// resource model.
+func newDataProductFieldModels(ctx context.Context, fields []neos.DataProductSchemaField) ([]DataProductFieldResourceModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	rtn := []DataProductFieldResourceModel{}
+	for _, v := range fields {
+		meta, d := types.MapValueFrom(ctx, types.StringType, v.DataType.Meta)
+		diags.Append(d...)
+		if diags.HasError() {
+			return rtn, diags
+		}
+
+		rtn = append(rtn, DataProductFieldResourceModel{
+			Name:        types.StringValue(v.Name),
+			Description: types.StringValue(v.Description),
+			Primary:     types.BoolValue(v.Primary),
+			Optional:    types.BoolValue(v.Optional),
+			DataType: DataProductDataTypeResourceModel{
+				ColumnType: types.StringValue(v.DataType.ColumnType),
+				Meta:       meta,
+			},
+		})
+	}
+	return rtn, diags
+}
+
+// checkPlannedSchemaChanges classifies the changes from the schema in the
+// state to the planned one and reports the breaking ones against the
+// attribute at p. It returns false when the changes are refused.
+func checkPlannedSchemaChanges(ctx context.Context, diags *diag.Diagnostics, p path.Path, state DataProductSchemaModel, plan DataProductSchemaModel, allowBreaking bool) bool {
+	changes, d := classifySchemaChanges(ctx, state, plan)
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+	for _, c := range changes {
+		tflog.Info(ctx, fmt.Sprintf("data product schema change %s: %s", c.Kind, c))
+	}
+	return checkSchemaChanges(diags, p, changes, allowBreaking)
+}
+
+// dataProductFieldsEqual reports whether the two lists hold the same fields in
+// the same order.
+func dataProductFieldsEqual(a []DataProductFieldResourceModel, b []DataProductFieldResourceModel) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if !a[i].Name.Equal(b[i].Name) ||
+			!a[i].Description.Equal(b[i].Description) ||
+			!a[i].Primary.Equal(b[i].Primary) ||
+			!a[i].Optional.Equal(b[i].Optional) ||
+			!a[i].DataType.ColumnType.Equal(b[i].DataType.ColumnType) ||
+			!a[i].DataType.Meta.Equal(b[i].DataType.Meta) {
+			return false
+		}
+	}
+	return true
+}
+
+// readDataProductSchema reads the current schema of the data product, it is
+// nil when the data product has no schema yet. NEOS does not return the product
+// type so it is null.
+func readDataProductSchema(ctx context.Context, client *neosAPIClient, id string) (*DataProductSchemaModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	result, err := client.DataProductSchemaGet(id)
+	if isNotFoundError(err) || (err == nil && len(result.Fields) == 0) {
+		return nil, diags
+	}
+	if err != nil {
+		diags.AddError("Error Reading NEOS data product schema", "Could not read NEOS data product schema ID "+id+": "+err.Error())
+		return nil, diags
+	}
+
+	fields, d := newDataProductFieldModels(ctx, result.Fields)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+	return &DataProductSchemaModel{ProductType: types.StringNull(), Fields: fields}, diags
+}
+
+// dataProductSchemaManagers holds the resource type that manages the schema of
+// each data product planned in this run.
+var dataProductSchemaManagers sync.Map
+
+// claimDataProductSchema records that the resource type manages the schema of
+// the data product and reports an error against the attribute at p when the
+// other resource type already does, the two would overwrite each other.
+func claimDataProductSchema(diags *diag.Diagnostics, p path.Path, id string, manager string) {
+	m, loaded := dataProductSchemaManagers.LoadOrStore(id, manager)
+	if loaded && m.(string) != manager {
+		diags.AddAttributeError(p, "Data product schema managed twice", fmt.Sprintf("The schema of data product %s is managed by both the schema of neos_data_product and neos_data_product_schema. Manage it with only one of them.", id))
+	}
+}
@@ -0,0 +1,269 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+)
+
+const (
+	schemaChangeAdditive = "additive"
+	schemaChangeWidening = "widening"
+	schemaChangeBreaking = "breaking"
+)
+
+// schemaChange is a change to a column of a data product schema, Field is
+// empty for a change to the schema itself.
+type schemaChange struct {
+	Kind   string
+	Field  string
+	Detail string
+}
+
+func (c schemaChange) String() string {
+	if c.Field == "" {
+		return c.Detail
+	}
+	return fmt.Sprintf("column %q %s", c.Field, c.Detail)
+}
+
+// widerColumnTypes maps a column type to the types it can be changed to
+// without losing values.
+var widerColumnTypes = map[string][]string{
+	"TINYINT":  {"SMALLINT", "INT", "INTEGER", "BIGINT"},
+	"SMALLINT": {"INT", "INTEGER", "BIGINT"},
+	"INT":      {"INTEGER", "BIGINT"},
+	"INTEGER":  {"INT", "BIGINT"},
+	"FLOAT":    {"DOUBLE"},
+	"REAL":     {"DOUBLE"},
+	"CHAR":     {"VARCHAR", "STRING"},
+	"VARCHAR":  {"STRING"},
+	"DATE":     {"TIMESTAMP"},
+}
+
+// classifySchemaChanges compares the schema in the state with the planned one,
+// columns are matched by name and a change to a description or to the order
+// of the columns is not a schema change. Values that are not known yet are
+// skipped. The first schema given to a product has no changes.
+func classifySchemaChanges(ctx context.Context, state DataProductSchemaModel, plan DataProductSchemaModel) ([]schemaChange, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	changes := []schemaChange{}
+
+	if len(state.Fields) == 0 {
+		return changes, diags
+	}
+
+	if state.ProductType.ValueString() != "" && plan.ProductType.ValueString() != "" && !plan.ProductType.IsUnknown() && state.ProductType.ValueString() != plan.ProductType.ValueString() {
+		changes = append(changes, schemaChange{Kind: schemaChangeBreaking, Detail: fmt.Sprintf("product type changes from %s to %s", state.ProductType.ValueString(), plan.ProductType.ValueString())})
+	}
+
+	planned := map[string]DataProductFieldResourceModel{}
+	for _, f := range plan.Fields {
+		if f.Name.IsUnknown() {
+			return changes, diags
+		}
+		planned[f.Name.ValueString()] = f
+	}
+
+	existing := map[string]bool{}
+	for _, from := range state.Fields {
+		name := from.Name.ValueString()
+		existing[name] = true
+
+		to, ok := planned[name]
+		if !ok {
+			changes = append(changes, schemaChange{Kind: schemaChangeBreaking, Field: name, Detail: "is removed"})
+			continue
+		}
+
+		c, d := classifyFieldChange(ctx, from, to)
+		diags.Append(d...)
+		changes = append(changes, c...)
+	}
+
+	for _, f := range plan.Fields {
+		name := f.Name.ValueString()
+		if existing[name] {
+			continue
+		}
+		switch {
+		case f.Primary.ValueBool():
+			changes = append(changes, schemaChange{Kind: schemaChangeBreaking, Field: name, Detail: "is added to the primary key"})
+		case !f.Optional.ValueBool():
+			changes = append(changes, schemaChange{Kind: schemaChangeBreaking, Field: name, Detail: "is added and is not optional"})
+		default:
+			changes = append(changes, schemaChange{Kind: schemaChangeAdditive, Field: name, Detail: "is added"})
+		}
+	}
+
+	return changes, diags
+}
+
+// classifyFieldChange compares a column in the state with the planned one.
+func classifyFieldChange(ctx context.Context, from DataProductFieldResourceModel, to DataProductFieldResourceModel) ([]schemaChange, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	changes := []schemaChange{}
+	name := from.Name.ValueString()
+
+	if !to.Primary.IsUnknown() && from.Primary.ValueBool() != to.Primary.ValueBool() {
+		detail := "is removed from the primary key"
+		if to.Primary.ValueBool() {
+			detail = "is added to the primary key"
+		}
+		changes = append(changes, schemaChange{Kind: schemaChangeBreaking, Field: name, Detail: detail})
+	}
+
+	if !to.Optional.IsUnknown() && from.Optional.ValueBool() != to.Optional.ValueBool() {
+		if to.Optional.ValueBool() {
+			changes = append(changes, schemaChange{Kind: schemaChangeWidening, Field: name, Detail: "becomes optional"})
+		} else {
+			changes = append(changes, schemaChange{Kind: schemaChangeBreaking, Field: name, Detail: "is no longer optional"})
+		}
+	}
+
+	if to.DataType.ColumnType.IsUnknown() || to.DataType.Meta.IsUnknown() {
+		return changes, diags
+	}
+
+	fromMeta := map[string]string{}
+	toMeta := map[string]string{}
+	diags.Append(from.DataType.Meta.ElementsAs(ctx, &fromMeta, true)...)
+	diags.Append(to.DataType.Meta.ElementsAs(ctx, &toMeta, true)...)
+	if diags.HasError() {
+		return changes, diags
+	}
+
+	fromType := strings.ToUpper(from.DataType.ColumnType.ValueString())
+	toType := strings.ToUpper(to.DataType.ColumnType.ValueString())
+	if fromType != toType {
+		kind := schemaChangeBreaking
+		for _, t := range widerColumnTypes[fromType] {
+			if t == toType {
+				kind = schemaChangeWidening
+			}
+		}
+		changes = append(changes, schemaChange{Kind: kind, Field: name, Detail: fmt.Sprintf("changes type from %s to %s", fromType, toType)})
+		return changes, diags
+	}
+
+	if !metaEqualExcept(fromMeta, toMeta) {
+		changes = append(changes, classifyMetaChange(name, toType, fromMeta, toMeta))
+	}
+
+	return changes, diags
+}
+
+// classifyMetaChange classifies a change to the meta of a column that keeps
+// its type. A longer VARCHAR or CHAR, or a DECIMAL that keeps at least as many
+// digits either side of the point, is widening, any other change is breaking.
+func classifyMetaChange(name string, columnType string, from map[string]string, to map[string]string) schemaChange {
+	breaking := schemaChange{Kind: schemaChangeBreaking, Field: name, Detail: fmt.Sprintf("changes %s meta from %s to %s", columnType, metaString(from), metaString(to))}
+
+	switch columnType {
+	case "VARCHAR", "CHAR":
+		fromLength, ok1 := metaInt(from, "length")
+		toLength, ok2 := metaInt(to, "length")
+		if !ok1 || !ok2 || !metaEqualExcept(from, to, "length") {
+			return breaking
+		}
+		if toLength >= fromLength {
+			return schemaChange{Kind: schemaChangeWidening, Field: name, Detail: fmt.Sprintf("%s length grows from %d to %d", columnType, fromLength, toLength)}
+		}
+		breaking.Detail = fmt.Sprintf("%s length shrinks from %d to %d", columnType, fromLength, toLength)
+		return breaking
+	case "DECIMAL":
+		fromPrecision, ok1 := metaInt(from, "precision")
+		toPrecision, ok2 := metaInt(to, "precision")
+		fromScale, ok3 := metaInt(from, "scale")
+		toScale, ok4 := metaInt(to, "scale")
+		if !ok1 || !ok2 || !ok3 || !ok4 || !metaEqualExcept(from, to, "precision", "scale") {
+			return breaking
+		}
+		if toScale >= fromScale && toPrecision-toScale >= fromPrecision-fromScale {
+			return schemaChange{Kind: schemaChangeWidening, Field: name, Detail: fmt.Sprintf("DECIMAL(%d,%d) widens to DECIMAL(%d,%d)", fromPrecision, fromScale, toPrecision, toScale)}
+		}
+		breaking.Detail = fmt.Sprintf("DECIMAL(%d,%d) narrows to DECIMAL(%d,%d)", fromPrecision, fromScale, toPrecision, toScale)
+		return breaking
+	}
+	return breaking
+}
+
+func metaInt(meta map[string]string, key string) (int, bool) {
+	v, ok := meta[key]
+	if !ok {
+		return 0, false
+	}
+	i, err := strconv.Atoi(v)
+	return i, err == nil
+}
+
+// metaEqualExcept reports if the meta are the same apart from the keys.
+func metaEqualExcept(a map[string]string, b map[string]string, keys ...string) bool {
+	skip := map[string]bool{}
+	for _, k := range keys {
+		skip[k] = true
+	}
+	for k, v := range a {
+		if w, ok := b[k]; !skip[k] && (!ok || v != w) {
+			return false
+		}
+	}
+	for k := range b {
+		if _, ok := a[k]; !skip[k] && !ok {
+			return false
+		}
+	}
+	return true
+}
+
+func metaString(meta map[string]string) string {
+	if len(meta) == 0 {
+		return "{}"
+	}
+	keys := make([]string, 0, len(meta))
+	for k := range meta {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	parts := []string{}
+	for _, k := range keys {
+		parts = append(parts, k+"="+meta[k])
+	}
+	return "{" + strings.Join(parts, ", ") + "}"
+}
+
+// checkSchemaChanges reports the breaking changes, as warnings when allowed
+// and as an error otherwise. It returns false when the changes are refused.
+func checkSchemaChanges(diags *diag.Diagnostics, p path.Path, changes []schemaChange, allowBreaking bool) bool {
+	breaking := []string{}
+	for _, c := range changes {
+		if c.Kind == schemaChangeBreaking {
+			breaking = append(breaking, "  - "+c.String())
+		}
+	}
+	if len(breaking) == 0 {
+		return true
+	}
+
+	if allowBreaking {
+		diags.AddAttributeWarning(
+			p,
+			"Breaking data product schema changes",
+			"The schema changes break existing consumers of the data product:\n"+strings.Join(breaking, "\n"),
+		)
+		return true
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Breaking data product schema changes",
+		"The schema changes break existing consumers of the data product:\n"+strings.Join(breaking, "\n")+
+			"\n\nSet allow_breaking_schema_changes = true to apply them.",
+	)
+	return false
+}
@@ -0,0 +1,290 @@
+package provider
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// testField is a column of a data product schema for the tests, meta is given
+// as key and value pairs.
+func testField(name string, columnType string, primary bool, optional bool, meta ...string) DataProductFieldResourceModel {
+	m := map[string]string{}
+	for i := 0; i+1 < len(meta); i += 2 {
+		m[meta[i]] = meta[i+1]
+	}
+	metaValue, _ := types.MapValueFrom(context.Background(), types.StringType, m)
+	return DataProductFieldResourceModel{
+		Name:        types.StringValue(name),
+		Description: types.StringValue(name + " column"),
+		Primary:     types.BoolValue(primary),
+		Optional:    types.BoolValue(optional),
+		DataType: DataProductDataTypeResourceModel{
+			ColumnType: types.StringValue(columnType),
+			Meta:       metaValue,
+		},
+	}
+}
+
+func testSchema(fields ...DataProductFieldResourceModel) DataProductSchemaModel {
+	return DataProductSchemaModel{ProductType: types.StringValue("stored"), Fields: fields}
+}
+
+func TestClassifySchemaChanges(t *testing.T) {
+	id := testField("id", "INT", true, false)
+	name := testField("name", "VARCHAR", false, false, "length", "50")
+	amount := testField("amount", "DECIMAL", false, true, "precision", "10", "scale", "2")
+	base := testSchema(id, name, amount)
+
+	retyped := func(f DataProductFieldResourceModel, columnType string, meta ...string) DataProductFieldResourceModel {
+		r := testField(f.Name.ValueString(), columnType, f.Primary.ValueBool(), f.Optional.ValueBool(), meta...)
+		r.Description = types.StringValue("changed description")
+		return r
+	}
+	flagged := func(f DataProductFieldResourceModel, primary bool, optional bool) DataProductFieldResourceModel {
+		f.Primary = types.BoolValue(primary)
+		f.Optional = types.BoolValue(optional)
+		return f
+	}
+
+	tests := []struct {
+		name  string
+		state DataProductSchemaModel
+		plan  DataProductSchemaModel
+		want  []string
+	}{
+		{
+			name:  "first schema has no changes",
+			state: testSchema(),
+			plan:  base,
+			want:  []string{},
+		},
+		{
+			name:  "unchanged",
+			state: base,
+			plan:  testSchema(id, name, amount),
+			want:  []string{},
+		},
+		{
+			name:  "reordered columns and a new description",
+			state: base,
+			plan:  testSchema(retyped(amount, "DECIMAL", "precision", "10", "scale", "2"), name, id),
+			want:  []string{},
+		},
+		{
+			name:  "added optional column",
+			state: base,
+			plan:  testSchema(id, name, amount, testField("note", "STRING", false, true)),
+			want:  []string{`additive: column "note" is added`},
+		},
+		{
+			name:  "added required column",
+			state: base,
+			plan:  testSchema(id, name, amount, testField("note", "STRING", false, false)),
+			want:  []string{`breaking: column "note" is added and is not optional`},
+		},
+		{
+			name:  "added primary key column",
+			state: base,
+			plan:  testSchema(id, name, amount, testField("region", "STRING", true, true)),
+			want:  []string{`breaking: column "region" is added to the primary key`},
+		},
+		{
+			name:  "column added to the primary key",
+			state: base,
+			plan:  testSchema(id, flagged(name, true, false), amount),
+			want:  []string{`breaking: column "name" is added to the primary key`},
+		},
+		{
+			name:  "column removed from the primary key",
+			state: base,
+			plan:  testSchema(flagged(id, false, false), name, amount),
+			want:  []string{`breaking: column "id" is removed from the primary key`},
+		},
+		{
+			name:  "column becomes optional",
+			state: base,
+			plan:  testSchema(id, flagged(name, false, true), amount),
+			want:  []string{`widening: column "name" becomes optional`},
+		},
+		{
+			name:  "column is no longer optional",
+			state: base,
+			plan:  testSchema(id, name, flagged(amount, false, false)),
+			want:  []string{`breaking: column "amount" is no longer optional`},
+		},
+		{
+			name:  "removed column",
+			state: base,
+			plan:  testSchema(id, amount),
+			want:  []string{`breaking: column "name" is removed`},
+		},
+		{
+			name:  "renamed column",
+			state: base,
+			plan:  testSchema(id, testField("full_name", "VARCHAR", false, false, "length", "50"), amount),
+			want:  []string{`breaking: column "name" is removed`, `breaking: column "full_name" is added and is not optional`},
+		},
+		{
+			name:  "INT to BIGINT",
+			state: base,
+			plan:  testSchema(retyped(id, "BIGINT"), name, amount),
+			want:  []string{`widening: column "id" changes type from INT to BIGINT`},
+		},
+		{
+			name:  "BIGINT to INT",
+			state: testSchema(testField("id", "BIGINT", true, false)),
+			plan:  testSchema(testField("id", "INT", true, false)),
+			want:  []string{`breaking: column "id" changes type from BIGINT to INT`},
+		},
+		{
+			name:  "VARCHAR to STRING",
+			state: base,
+			plan:  testSchema(id, retyped(name, "STRING"), amount),
+			want:  []string{`widening: column "name" changes type from VARCHAR to STRING`},
+		},
+		{
+			name:  "VARCHAR to INT",
+			state: base,
+			plan:  testSchema(id, retyped(name, "INT"), amount),
+			want:  []string{`breaking: column "name" changes type from VARCHAR to INT`},
+		},
+		{
+			name:  "VARCHAR grows",
+			state: base,
+			plan:  testSchema(id, retyped(name, "VARCHAR", "length", "100"), amount),
+			want:  []string{`widening: column "name" VARCHAR length grows from 50 to 100`},
+		},
+		{
+			name:  "VARCHAR shrinks",
+			state: base,
+			plan:  testSchema(id, retyped(name, "VARCHAR", "length", "20"), amount),
+			want:  []string{`breaking: column "name" VARCHAR length shrinks from 50 to 20`},
+		},
+		{
+			name:  "DECIMAL widens",
+			state: base,
+			plan:  testSchema(id, name, retyped(amount, "DECIMAL", "precision", "12", "scale", "3")),
+			want:  []string{`widening: column "amount" DECIMAL(10,2) widens to DECIMAL(12,3)`},
+		},
+		{
+			name:  "DECIMAL narrows",
+			state: base,
+			plan:  testSchema(id, name, retyped(amount, "DECIMAL", "precision", "8", "scale", "2")),
+			want:  []string{`breaking: column "amount" DECIMAL(10,2) narrows to DECIMAL(8,2)`},
+		},
+		{
+			name:  "product type changes",
+			state: base,
+			plan:  DataProductSchemaModel{ProductType: types.StringValue("streamed"), Fields: base.Fields},
+			want:  []string{"breaking: product type changes from stored to streamed"},
+		},
+		{
+			name:  "unknown column names are skipped",
+			state: base,
+			plan: testSchema(DataProductFieldResourceModel{
+				Name:     types.StringUnknown(),
+				Primary:  types.BoolValue(false),
+				Optional: types.BoolValue(true),
+			}),
+			want: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			changes, diags := classifySchemaChanges(context.Background(), tt.state, tt.plan)
+			if diags.HasError() {
+				t.Fatalf("unexpected diagnostics: %v", diags)
+			}
+			got := []string{}
+			for _, c := range changes {
+				got = append(got, c.Kind+": "+c.String())
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("classifySchemaChanges() =\n%q\nwant\n%q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClassifyMetaChange(t *testing.T) {
+	tests := []struct {
+		name       string
+		columnType string
+		from       map[string]string
+		to         map[string]string
+		want       string
+	}{
+		{name: "VARCHAR grows", columnType: "VARCHAR", from: map[string]string{"length": "10"}, to: map[string]string{"length": "20"}, want: schemaChangeWidening},
+		{name: "VARCHAR shrinks", columnType: "VARCHAR", from: map[string]string{"length": "20"}, to: map[string]string{"length": "10"}, want: schemaChangeBreaking},
+		{name: "CHAR grows", columnType: "CHAR", from: map[string]string{"length": "1"}, to: map[string]string{"length": "2"}, want: schemaChangeWidening},
+		{name: "VARCHAR length removed", columnType: "VARCHAR", from: map[string]string{"length": "20"}, to: map[string]string{}, want: schemaChangeBreaking},
+		{name: "VARCHAR length not a number", columnType: "VARCHAR", from: map[string]string{"length": "20"}, to: map[string]string{"length": "max"}, want: schemaChangeBreaking},
+		{name: "VARCHAR other meta changes", columnType: "VARCHAR", from: map[string]string{"length": "10", "collation": "en"}, to: map[string]string{"length": "20", "collation": "fr"}, want: schemaChangeBreaking},
+		{name: "DECIMAL more digits before the point", columnType: "DECIMAL", from: map[string]string{"precision": "10", "scale": "2"}, to: map[string]string{"precision": "12", "scale": "2"}, want: schemaChangeWidening},
+		{name: "DECIMAL more digits after the point", columnType: "DECIMAL", from: map[string]string{"precision": "10", "scale": "2"}, to: map[string]string{"precision": "11", "scale": "3"}, want: schemaChangeWidening},
+		{name: "DECIMAL less precision", columnType: "DECIMAL", from: map[string]string{"precision": "10", "scale": "2"}, to: map[string]string{"precision": "9", "scale": "2"}, want: schemaChangeBreaking},
+		{name: "DECIMAL scale grows into the integer digits", columnType: "DECIMAL", from: map[string]string{"precision": "10", "scale": "2"}, to: map[string]string{"precision": "10", "scale": "4"}, want: schemaChangeBreaking},
+		{name: "DECIMAL scale shrinks", columnType: "DECIMAL", from: map[string]string{"precision": "10", "scale": "2"}, to: map[string]string{"precision": "10", "scale": "0"}, want: schemaChangeBreaking},
+		{name: "DECIMAL scale missing", columnType: "DECIMAL", from: map[string]string{"precision": "10", "scale": "2"}, to: map[string]string{"precision": "12"}, want: schemaChangeBreaking},
+		{name: "other types", columnType: "TIMESTAMP", from: map[string]string{"zone": "utc"}, to: map[string]string{"zone": "local"}, want: schemaChangeBreaking},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := classifyMetaChange("column", tt.columnType, tt.from, tt.to)
+			if got.Kind != tt.want {
+				t.Errorf("classifyMetaChange(%s, %v, %v) = %s (%s), want %s", tt.columnType, tt.from, tt.to, got.Kind, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestClaimDataProductSchema(t *testing.T) {
+	var diags diag.Diagnostics
+	claimDataProductSchema(&diags, path.Root("schema"), "claim-test-1", "neos_data_product")
+	claimDataProductSchema(&diags, path.Root("schema"), "claim-test-1", "neos_data_product")
+	claimDataProductSchema(&diags, path.Root("fields"), "claim-test-2", "neos_data_product_schema")
+	if diags.HasError() {
+		t.Fatalf("unexpected diagnostics: %v", diags)
+	}
+
+	claimDataProductSchema(&diags, path.Root("data_product_id"), "claim-test-1", "neos_data_product_schema")
+	if len(diags.Errors()) != 1 || diags.Errors()[0].Summary() != "Data product schema managed twice" {
+		t.Errorf("diagnostics = %v, want one Data product schema managed twice error", diags)
+	}
+}
+
+func TestDataProductFieldsEqual(t *testing.T) {
+	id := testField("id", "INT", true, false)
+	name := testField("name", "VARCHAR", false, false, "length", "50")
+	described := name
+	described.Description = types.StringValue("changed description")
+
+	tests := []struct {
+		name string
+		a    []DataProductFieldResourceModel
+		b    []DataProductFieldResourceModel
+		want bool
+	}{
+		{name: "same", a: []DataProductFieldResourceModel{id, name}, b: []DataProductFieldResourceModel{id, name}, want: true},
+		{name: "both empty", a: nil, b: []DataProductFieldResourceModel{}, want: true},
+		{name: "reordered", a: []DataProductFieldResourceModel{id, name}, b: []DataProductFieldResourceModel{name, id}, want: false},
+		{name: "removed", a: []DataProductFieldResourceModel{id, name}, b: []DataProductFieldResourceModel{id}, want: false},
+		{name: "description", a: []DataProductFieldResourceModel{name}, b: []DataProductFieldResourceModel{described}, want: false},
+		{name: "meta", a: []DataProductFieldResourceModel{name}, b: []DataProductFieldResourceModel{testField("name", "VARCHAR", false, false, "length", "60")}, want: false},
+		{name: "column type", a: []DataProductFieldResourceModel{id}, b: []DataProductFieldResourceModel{testField("id", "BIGINT", true, false)}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := dataProductFieldsEqual(tt.a, tt.b); got != tt.want {
+				t.Errorf("dataProductFieldsEqual() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,192 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewDataProductSchemaDataSource() datasource.DataSource {
+	return &dataProductSchemaDataSource{}
+}
+
+var (
+	_ datasource.DataSource                     = &dataProductSchemaDataSource{}
+	_ datasource.DataSourceWithConfigure        = &dataProductSchemaDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &dataProductSchemaDataSource{}
+)
+
+// dataProductSchemaDataSource reads the schema of an existing data product.
+type dataProductSchemaDataSource struct {
+	client    *neos.DataProductClient
+	apiClient *neosAPIClient
+}
+
+type dataProductSchemaDataSourceModel struct {
+	ID                types.String                    `tfsdk:"id"`
+	Name              types.String                    `tfsdk:"name"`
+	Urn               types.String                    `tfsdk:"urn"`
+	Fields            []DataProductFieldResourceModel `tfsdk:"fields"`
+	ColumnNames       types.List                      `tfsdk:"column_names"`
+	PrimaryKeyColumns types.List                      `tfsdk:"primary_key_columns"`
+	OptionalColumns   types.List                      `tfsdk:"optional_columns"`
+}
+
+func (d *dataProductSchemaDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_product_schema"
+}
+
+func (d *dataProductSchemaDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := map[string]schema.Attribute{
+		"fields": schema.ListNestedAttribute{
+			Computed:    true,
+			Description: "The fields of the schema in order, in the same shape as the fields of neos_data_product_schema",
+			NestedObject: schema.NestedAttributeObject{
+				Attributes: map[string]schema.Attribute{
+					"name": schema.StringAttribute{
+						Computed: true,
+					},
+					"description": schema.StringAttribute{
+						Computed: true,
+					},
+					"primary": schema.BoolAttribute{
+						Computed: true,
+					},
+					"optional": schema.BoolAttribute{
+						Computed: true,
+					},
+					"data_type": schema.SingleNestedAttribute{
+						Computed: true,
+						Attributes: map[string]schema.Attribute{
+							"column_type": schema.StringAttribute{
+								Computed: true,
+							},
+							"meta": schema.MapAttribute{
+								ElementType: types.StringType,
+								Computed:    true,
+							},
+						},
+					},
+				},
+			},
+		},
+		"column_names": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The names of the fields in order",
+		},
+		"primary_key_columns": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The names of the fields in the primary key in order",
+		},
+		"optional_columns": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Description: "The names of the optional fields in order",
+		},
+	}
+	setLookupAttributes(attributes, "data product", "id", "name", "urn")
+
+	resp.Schema = schema.Schema{
+		Description: "Reads the schema of a data product",
+		Attributes:  attributes,
+	}
+}
+
+func (d *dataProductSchemaDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
+}
+
+func (d *dataProductSchemaDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "dataProductSchemaDataSource READ")
+
+	var state dataProductSchemaDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	list, err := d.client.Get()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Data Product List", err.Error())
+		return
+	}
+
+	lookup := entityLookup{ID: state.ID, Name: state.Name, URN: state.Urn}
+	matches := filterEntities(list.Entities, func(e neos.DataProduct) bool {
+		return lookup.matches(e.Identifier, e.Name, e.Urn)
+	})
+	if !expectSingleEntity(&resp.Diagnostics, "data product", lookup.String(), len(matches)) {
+		return
+	}
+	product := matches[0]
+
+	// A data product without a schema has no fields.
+	result, err := d.apiClient.DataProductSchemaGet(product.Identifier)
+	if err != nil && !isNotFoundError(err) {
+		resp.Diagnostics.AddError("Unable to Read Data Product Schema", "Could not read the schema of data product "+product.Identifier+": "+err.Error())
+		return
+	}
+
+	fields, diags := newDataProductFieldModels(ctx, result.Fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	columns, primary, optional := []string{}, []string{}, []string{}
+	for _, f := range result.Fields {
+		columns = append(columns, f.Name)
+		if f.Primary {
+			primary = append(primary, f.Name)
+		}
+		if f.Optional {
+			optional = append(optional, f.Name)
+		}
+	}
+
+	state.ID = types.StringValue(product.Identifier)
+	state.Name = types.StringValue(product.Name)
+	state.Urn = types.StringValue(product.Urn)
+	state.Fields = fields
+	state.ColumnNames, diags = types.ListValueFrom(ctx, types.StringType, columns)
+	resp.Diagnostics.Append(diags...)
+	state.PrimaryKeyColumns, diags = types.ListValueFrom(ctx, types.StringType, primary)
+	resp.Diagnostics.Append(diags...)
+	state.OptionalColumns, diags = types.ListValueFrom(ctx, types.StringType, optional)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *dataProductSchemaDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "dataProductSchemaDataSource configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected dataProductSchemaDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = &client.DataProductClient
+	d.apiClient = client.API
+}
@@ -0,0 +1,342 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewDataProductSchemaResource() resource.Resource {
+	return &dataProductSchemaResource{}
+}
+
+// dataProductSchemaResource manages the schema of a data product apart from
+// the data product itself.
+type dataProductSchemaResource struct {
+	client        *neos.DataProductSchemaClient
+	productClient *neos.DataProductClient
+	apiClient     *neosAPIClient
+}
+
+var (
+	_ resource.Resource                = &dataProductSchemaResource{}
+	_ resource.ResourceWithConfigure   = &dataProductSchemaResource{}
+	_ resource.ResourceWithImportState = &dataProductSchemaResource{}
+	_ resource.ResourceWithModifyPlan  = &dataProductSchemaResource{}
+)
+
+// Metadata returns the resource type name.
+func (r *dataProductSchemaResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_product_schema"
+}
+
+// Schema defines the schema for the resource.
+func (r *dataProductSchemaResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "The schema of a data product, managed apart from the data product",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The identifier of the data product",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"data_product_id": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The identifier of the data product the schema belongs to",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"product_type": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "product type 'stored' etc",
+			},
+			"fields": schema.ListNestedAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The fields of the schema",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: dataProductSchemaFieldAttributes(),
+				},
+			},
+			"allow_breaking_schema_changes": schema.BoolAttribute{
+				Computed:    false,
+				Required:    false,
+				Optional:    true,
+				Description: "When true schema changes that break existing consumers, such as removing a column, changing the primary key or narrowing a column type, are applied with a warning rather than refused",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "Last updated time",
+			},
+		},
+	}
+}
+
+// dataProductSchemaResourceModel maps the resource schema data.
+type dataProductSchemaResourceModel struct {
+	ID            types.String                    `tfsdk:"id"`
+	DataProductID types.String                    `tfsdk:"data_product_id"`
+	ProductType   types.String                    `tfsdk:"product_type"`
+	Fields        []DataProductFieldResourceModel `tfsdk:"fields"`
+	AllowBreaking types.Bool                      `tfsdk:"allow_breaking_schema_changes"`
+	LastUpdated   types.String                    `tfsdk:"last_updated"`
+}
+
+func (m dataProductSchemaResourceModel) schema() DataProductSchemaModel {
+	return DataProductSchemaModel{ProductType: m.ProductType, Fields: m.Fields}
+}
+
+// ModifyPlan classifies the schema changes and refuses breaking ones unless
+// allow_breaking_schema_changes is set, a new schema is classified against the
+// one the data product already has. It waits for the apply when the fields
+// are not fully known.
+func (r *dataProductSchemaResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var dataProductID types.String
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("data_product_id"), &dataProductID)...)
+	if resp.Diagnostics.HasError() || dataProductID.IsUnknown() {
+		return
+	}
+	claimDataProductSchema(&resp.Diagnostics, path.Root("data_product_id"), dataProductID.ValueString(), "neos_data_product_schema")
+
+	var planFields types.List
+	resp.Diagnostics.Append(req.Plan.GetAttribute(ctx, path.Root("fields"), &planFields)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	raw, err := planFields.ToTerraformValue(ctx)
+	if err != nil || !raw.IsFullyKnown() {
+		return
+	}
+
+	var plan, state dataProductSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if !req.State.Raw.IsNull() {
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+	if req.State.Raw.IsNull() || !plan.DataProductID.Equal(state.DataProductID) {
+		r.checkCurrentSchema(ctx, plan, &resp.Diagnostics)
+		return
+	}
+
+	checkPlannedSchemaChanges(ctx, &resp.Diagnostics, path.Root("fields"), state.schema(), plan.schema(), plan.AllowBreaking.ValueBool())
+}
+
+// checkCurrentSchema classifies the changes from the schema the data product
+// has in NEOS to the planned one, it returns false when they are refused.
+func (r *dataProductSchemaResource) checkCurrentSchema(ctx context.Context, plan dataProductSchemaResourceModel, diags *diag.Diagnostics) bool {
+	current, d := readDataProductSchema(ctx, r.apiClient, plan.DataProductID.ValueString())
+	diags.Append(d...)
+	if diags.HasError() {
+		return false
+	}
+	if current == nil {
+		return true
+	}
+	return checkPlannedSchemaChanges(ctx, diags, path.Root("fields"), *current, plan.schema(), plan.AllowBreaking.ValueBool())
+}
+
+// Create sets the schema of the data product.
+func (r *dataProductSchemaResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan dataProductSchemaResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The schema replaces the one the data product may already have.
+	if !r.checkCurrentSchema(ctx, plan, &resp.Diagnostics) {
+		return
+	}
+
+	r.put(ctx, &plan, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// put sets the schema of the data product to the plan and updates the plan
+// with the schema NEOS returns.
+func (r *dataProductSchemaResource) put(ctx context.Context, plan *dataProductSchemaResourceModel, diags *diag.Diagnostics) {
+	id := plan.DataProductID.ValueString()
+
+	schemaPutRequest, d := newDataProductSchemaPutRequest(ctx, plan.ProductType.ValueString(), plan.Fields)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("dataProductSchemaResource put %s", id))
+	result, err := r.client.Put(ctx, id, schemaPutRequest)
+	if err != nil {
+		diags.AddError("Error putting data product schema", "Could not put data product schema "+id+", unexpected error: "+err.Error())
+		return
+	}
+
+	fields, d := newDataProductFieldModels(ctx, dataProductSchemaPutResponseFields(result))
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+	keepColumnTypeCase(plan.Fields, fields)
+
+	plan.ID = types.StringValue(id)
+	plan.Fields = fields
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+}
+
+// Read refreshes the Terraform state with the schema in NEOS, the resource is
+// removed when the data product is gone or no longer has a schema.
+func (r *dataProductSchemaResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state dataProductSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An imported schema only has its id.
+	if state.DataProductID.IsNull() {
+		state.DataProductID = state.ID
+	}
+	id := state.DataProductID.ValueString()
+
+	result, err := r.apiClient.DataProductSchemaGet(id)
+	if isNotFoundError(err) || (err == nil && len(result.Fields) == 0) {
+		tflog.Info(ctx, fmt.Sprintf("dataProductSchemaResource schema %s not found, removing from state", id))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading NEOS data product schema", "Could not read NEOS data product schema ID "+id+": "+err.Error())
+		return
+	}
+
+	fields, diags := newDataProductFieldModels(ctx, result.Fields)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	keepColumnTypeCase(state.Fields, fields)
+
+	// NEOS does not return the product type, the one in the state is kept and
+	// an imported schema has none until the next apply.
+	state.ID = types.StringValue(id)
+	state.Fields = fields
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update puts the whole schema again.
+func (r *dataProductSchemaResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state dataProductSchemaResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The fields may only have been known after the plan was made.
+	if !checkPlannedSchemaChanges(ctx, &resp.Diagnostics, path.Root("fields"), state.schema(), plan.schema(), plan.AllowBreaking.ValueBool()) {
+		return
+	}
+
+	// An imported schema only takes the configured product type, there is
+	// nothing to put when the fields are the same.
+	if !state.ProductType.IsNull() || !dataProductFieldsEqual(state.Fields, plan.Fields) {
+		r.put(ctx, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	}
+
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the schema from the Terraform state, NEOS has no way to take
+// the schema off a data product so it stays until the product is deleted.
+func (r *dataProductSchemaResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state dataProductSchemaResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("dataProductSchemaResource Delete %s, the schema is left on the data product", state.DataProductID.ValueString()))
+}
+
+func (r *dataProductSchemaResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = &client.DataProductSchemaClient
+	r.productClient = &client.DataProductClient
+	r.apiClient = client.API
+}
+
+// ImportState imports the schema by the id, NEOS URN or name:<name> of its
+// data product.
+func (r *dataProductSchemaResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importCatalogueEntity(ctx, req, resp, "data product", func() ([]neos.DataProduct, error) {
+		list, err := r.productClient.Get()
+		return list.Entities, err
+	}, func(e neos.DataProduct) (string, string, string) {
+		return e.Identifier, e.Name, e.Urn
+	})
+}
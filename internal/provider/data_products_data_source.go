@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewDataProductsDataSource() datasource.DataSource {
+	return &dataProductsDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &dataProductsDataSource{}
+	_ datasource.DataSourceWithConfigure = &dataProductsDataSource{}
+)
+
+type dataProductsDataSource struct {
+	client    *neos.DataProductClient
+	apiClient *neosAPIClient
+}
+
+func (d *dataProductsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_products"
+}
+
+func (d *dataProductsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "dataProductsDataSource READ")
+
+	var state DataProductsDataSourceModelV2
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, ok := nameRegexFilter(&resp.Diagnostics, state.NameRegex)
+	if !ok {
+		return
+	}
+
+	// The client lists the entities of the provider account.
+	var list neos.DataProductList
+	var err error
+	if state.Account.IsNull() {
+		list, err = d.client.Get()
+	} else {
+		err = d.apiClient.EntityList("data_product", state.Account.ValueString(), &list)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Product List",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("dataProductsDataSource READ length %d", len(list.Entities)))
+
+	matches := filterEntities(list.Entities, func(e neos.DataProduct) bool {
+		return nameRegex.MatchString(e.Name) && matchesFilter(state.Owner, e.Owner) && matchesFilter(state.Label, e.Label)
+	})
+
+	// Map response body to model
+	state.DataProducts = []DataProductModelV2{}
+	for _, e := range matches {
+		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", e.Identifier))
+		state.DataProducts = append(state.DataProducts, newDataProductModelV2(e))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (d *dataProductsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "dataProductsDataSource Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected dataProductsDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+
+		return
+	}
+
+	d.client = &client.DataProductClient
+	d.apiClient = client.API
+}
+
+func (d *dataProductsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account":    pluralFilterAttribute("Only return data products of this account, defaults to the provider account"),
+			"name_regex": pluralFilterAttribute("Only return data products whose name matches this regular expression"),
+			"owner":      pluralFilterAttribute("Only return data products with this owner"),
+			"label":      pluralFilterAttribute("Only return data products with this label"),
+			"data_products": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The data products matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: catalogueEntityDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+type DataProductsDataSourceModelV2 struct {
+	Account      types.String         `tfsdk:"account"`
+	NameRegex    types.String         `tfsdk:"name_regex"`
+	Owner        types.String         `tfsdk:"owner"`
+	Label        types.String         `tfsdk:"label"`
+	DataProducts []DataProductModelV2 `tfsdk:"data_products"`
+}
@@ -16,8 +16,9 @@ func NewDataSourceDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &dataSourceDataSourceV2{}
-	_ datasource.DataSourceWithConfigure = &dataSourceDataSourceV2{}
+	_ datasource.DataSource                     = &dataSourceDataSourceV2{}
+	_ datasource.DataSourceWithConfigure        = &dataSourceDataSourceV2{}
+	_ datasource.DataSourceWithConfigValidators = &dataSourceDataSourceV2{}
 )
 
 type dataSourceDataSourceV2 struct {
@@ -30,39 +31,36 @@ func (d *dataSourceDataSourceV2) Metadata(_ context.Context, req datasource.Meta
 
 func (d *dataSourceDataSourceV2) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
-	tflog.Info(ctx, "Abi READ")
+	tflog.Info(ctx, "dataSourceDataSourceV2 READ")
 
-	var state DataSourceDataSourceModelV2
+	var config DataSourceModelV2
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.Get()
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read Data System List",
+			"Unable to Read Data Source List",
 			err.Error(),
 		)
 		return
 	}
 
-	tflog.Info(ctx, "READ Post error ")
-
-	tflog.Info(ctx, fmt.Sprintf("READ length %d", len(list.Entities)))
-
-	// Map response body to model
-	for _, ds := range list.Entities {
-		dataSourceState := DataSourceModelV2{
-			Identifier:  types.StringValue(ds.Identifier),
-			Name:        types.StringValue(ds.Name),
-			Description: types.StringValue(ds.Description),
-			Label:       types.StringValue(ds.Label),
-			Owner:       types.StringValue(ds.Owner),
-			Urn:         types.StringValue(ds.Urn),
-		}
-		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Identifier))
-		state.DataSources = append(state.DataSources, dataSourceState)
+	lookup := entityLookup{ID: config.Identifier, Name: config.Name, URN: config.Urn}
+	matches := filterEntities(list.Entities, func(e neos.DataSource) bool {
+		return lookup.matches(e.Identifier, e.Name, e.Urn)
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "data source", lookup.String(), len(matches)) {
+		return
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
+	state := newDataSourceModelV2(matches[0])
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -70,17 +68,16 @@ func (d *dataSourceDataSourceV2) Read(ctx context.Context, req datasource.ReadRe
 
 }
 
-// Configure adds the provider configured client to the data source.
 func (d *dataSourceDataSourceV2) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	tflog.Info(ctx, "Data source configure")
+	tflog.Info(ctx, "dataSourceDataSourceV2 Data source configure")
 
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected dataSourceDataSourceV2 Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected dataSourceDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 
 		return
 	}
@@ -88,60 +85,19 @@ func (d *dataSourceDataSourceV2) Configure(ctx context.Context, req datasource.C
 	d.client = &client.DataSourceClient
 }
 
+func (d *dataSourceDataSourceV2) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
+}
+
 func (d *dataSourceDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := catalogueEntityDataSourceAttributes()
+	setLookupAttributes(attributes, "data source", "id", "name", "urn")
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"datasystems": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"label": schema.StringAttribute{
-							Computed: true,
-						},
-						"created_at": schema.StringAttribute{
-							Computed: true,
-						},
-						"owner": schema.StringAttribute{
-							Computed: true,
-						},
-						"connection_json": schema.StringAttribute{
-							Computed: true,
-						},
-						"state": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"state": schema.StringAttribute{
-									Computed: true,
-								},
-								"healthy": schema.BoolAttribute{
-									Computed: true,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type DataSourceDataSourceModelV2 struct {
-	DataSources []DataSourceModelV2 `tfsdk:"datasource"`
-}
-
-// coffeesModel maps coffees schema data.
 type DataSourceModelV2 struct {
 	Identifier  types.String           `tfsdk:"id"`
 	Urn         types.String           `tfsdk:"urn"`
@@ -157,3 +113,19 @@ type DataSourceStateModelV2 struct {
 	State   types.String `tfsdk:"state"`
 	Healthy types.Bool   `tfsdk:"healthy"`
 }
+
+func newDataSourceModelV2(e neos.DataSource) DataSourceModelV2 {
+	return DataSourceModelV2{
+		Identifier:  types.StringValue(e.Identifier),
+		Name:        types.StringValue(e.Name),
+		Description: types.StringValue(e.Description),
+		Label:       types.StringValue(e.Label),
+		Owner:       types.StringValue(e.Owner),
+		Urn:         types.StringValue(e.Urn),
+		CreatedAt:   types.StringValue(e.CreatedAt.String()),
+		State: DataSourceStateModelV2{
+			State:   types.StringValue(e.State.State),
+			Healthy: types.BoolValue(e.State.Healthy),
+		},
+	}
+}
@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,9 +26,11 @@ func NewDataSourceResource() resource.Resource {
 // dataSourceResource is the resource implementation.
 type dataSourceResource struct {
 	client                 *neos.DataSourceClient
+	linksClient            *neos.LinksClient
 	connectionClient       *neos.DataSourceConnectionClient
 	dataSourceSecretClient *neos.DataSourceSecretClient
 	secretClient           *neos.SecretClient
+	apiClient              *neosAPIClient
 }
 
 var (
@@ -137,6 +138,8 @@ func (r *dataSourceResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 				Description: "list of links",
 			},
+			"deletion_protection": deletionProtectionAttribute("data source"),
+			"force_destroy":       forceDestroyAttribute("data source"),
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -155,10 +158,12 @@ type dataSourceResourceModel struct {
 	CreatedAt      types.String `tfsdk:"created_at"`
 	ConnectionJson types.String `tfsdk:"connection_json"`
 	//SecretJson     types.String `tfsdk:"secret_json"`
-	SecretValues types.Map    `tfsdk:"secret_values"`
-	Links        types.List   `tfsdk:"links"`
-	ContactIds   types.List   `tfsdk:"contact_ids"`
-	LastUpdated  types.String `tfsdk:"last_updated"`
+	SecretValues       types.Map    `tfsdk:"secret_values"`
+	Links              types.List   `tfsdk:"links"`
+	ContactIds         types.List   `tfsdk:"contact_ids"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool   `tfsdk:"force_destroy"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
 }
 
 // Create a new resource.
@@ -302,8 +307,16 @@ func (r *dataSourceResource) Read(ctx context.Context, req resource.ReadRequest,
 			state.Label = types.StringValue(ds.Label)
 			state.URN = types.StringValue(ds.Urn)
 			state.Description = types.StringValue(ds.Description)
-			state.Owner = types.StringValue(ds.Owner)
 			state.CreatedAt = types.StringValue(ds.CreatedAt.String())
+
+			info, diags := readEntityInfo(ctx, r.apiClient, "data_source", ds.Identifier)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.Owner = info.Owner
+			state.ContactIds = info.ContactIds
+			state.Links = info.Links
 			break
 		}
 	}
@@ -490,6 +503,18 @@ func (r *dataSourceResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if !checkDeletionProtection(&resp.Diagnostics, plan.DeletionProtection, "data source", plan.Name.ValueString()) {
+		return
+	}
+
+	if plan.ForceDestroy.ValueBool() {
+		err := deleteEntityLinks(ctx, r.linksClient, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting data source", "Could not remove the links of the data source, unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	err := r.client.Delete(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -506,7 +531,7 @@ func (r *dataSourceResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.DataSourceClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -514,13 +539,20 @@ func (r *dataSourceResource) Configure(_ context.Context, req resource.Configure
 	}
 
 	r.client = &client.DataSourceClient
+	r.linksClient = &client.LinksClient
+	r.apiClient = client.API
 	r.connectionClient = &client.DataSourceConnectionClient
 	r.dataSourceSecretClient = &client.DataSourceSecretClient
 	r.secretClient = &client.SecretClient
 
 }
 
+// ImportState imports the resource by its id, NEOS URN or name:<name>.
 func (r *dataSourceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importCatalogueEntity(ctx, req, resp, "data source", func() ([]neos.DataSource, error) {
+		list, err := r.client.Get()
+		return list.Entities, err
+	}, func(e neos.DataSource) (string, string, string) {
+		return e.Identifier, e.Name, e.Urn
+	})
 }
@@ -16,8 +16,9 @@ func NewDataSystemDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &dataSystemDataSourceV2{}
-	_ datasource.DataSourceWithConfigure = &dataSystemDataSourceV2{}
+	_ datasource.DataSource                     = &dataSystemDataSourceV2{}
+	_ datasource.DataSourceWithConfigure        = &dataSystemDataSourceV2{}
+	_ datasource.DataSourceWithConfigValidators = &dataSystemDataSourceV2{}
 )
 
 type dataSystemDataSourceV2 struct {
@@ -30,9 +31,14 @@ func (d *dataSystemDataSourceV2) Metadata(_ context.Context, req datasource.Meta
 
 func (d *dataSystemDataSourceV2) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
-	tflog.Info(ctx, "Abi READ")
+	tflog.Info(ctx, "dataSystemDataSourceV2 READ")
 
-	var state DataSystemDataSourceModelV2
+	var config DataSystemModelV2
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.Get()
 	if err != nil {
@@ -43,26 +49,18 @@ func (d *dataSystemDataSourceV2) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	tflog.Info(ctx, "Abi READ Post error ")
-
-	tflog.Info(ctx, fmt.Sprintf("Abi READ length %d", len(list.Entities)))
-
-	// Map response body to model
-	for _, ds := range list.Entities {
-		dataSystemState := DataSystemModelV2{
-			Identifier:  types.StringValue(ds.Identifier),
-			Name:        types.StringValue(ds.Name),
-			Description: types.StringValue(ds.Description),
-			Label:       types.StringValue(ds.Label),
-			Owner:       types.StringValue(ds.Owner),
-			Urn:         types.StringValue(ds.Urn),
-		}
-		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Identifier))
-		state.DataSystems = append(state.DataSystems, dataSystemState)
+	lookup := entityLookup{ID: config.Identifier, Name: config.Name, URN: config.Urn}
+	matches := filterEntities(list.Entities, func(e neos.DataSystem) bool {
+		return lookup.matches(e.Identifier, e.Name, e.Urn)
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "data system", lookup.String(), len(matches)) {
+		return
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
+	state := newDataSystemModelV2(matches[0])
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -70,16 +68,16 @@ func (d *dataSystemDataSourceV2) Read(ctx context.Context, req datasource.ReadRe
 
 }
 
-// Configure adds the provider configured client to the data source.
 func (d *dataSystemDataSourceV2) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "dataSystemDataSourceV2 Data source configure")
 
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected dataSystemDataSourceV2 Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected dataSystemDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 
 		return
 	}
@@ -87,57 +85,19 @@ func (d *dataSystemDataSourceV2) Configure(ctx context.Context, req datasource.C
 	d.client = &client.DataSystemClient
 }
 
+func (d *dataSystemDataSourceV2) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
+}
+
 func (d *dataSystemDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := catalogueEntityDataSourceAttributes()
+	setLookupAttributes(attributes, "data system", "id", "name", "urn")
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"datasystems": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"label": schema.StringAttribute{
-							Computed: true,
-						},
-						"created_at": schema.StringAttribute{
-							Computed: true,
-						},
-						"owner": schema.StringAttribute{
-							Computed: true,
-						},
-						"state": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"state": schema.StringAttribute{
-									Computed: true,
-								},
-								"healthy": schema.BoolAttribute{
-									Computed: true,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type DataSystemDataSourceModelV2 struct {
-	DataSystems []DataSystemModelV2 `tfsdk:"datasystems"`
-}
-
-// coffeesModel maps coffees schema data.
 type DataSystemModelV2 struct {
 	Identifier  types.String           `tfsdk:"id"`
 	Urn         types.String           `tfsdk:"urn"`
@@ -153,3 +113,19 @@ type DataSystemStateModelV2 struct {
 	State   types.String `tfsdk:"state"`
 	Healthy types.Bool   `tfsdk:"healthy"`
 }
+
+func newDataSystemModelV2(e neos.DataSystem) DataSystemModelV2 {
+	return DataSystemModelV2{
+		Identifier:  types.StringValue(e.Identifier),
+		Name:        types.StringValue(e.Name),
+		Description: types.StringValue(e.Description),
+		Label:       types.StringValue(e.Label),
+		Owner:       types.StringValue(e.Owner),
+		Urn:         types.StringValue(e.Urn),
+		CreatedAt:   types.StringValue(e.CreatedAt.String()),
+		State: DataSystemStateModelV2{
+			State:   types.StringValue(e.State.State),
+			Healthy: types.BoolValue(e.State.Healthy),
+		},
+	}
+}
@@ -6,7 +6,6 @@ import (
 
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -28,7 +27,9 @@ func NewDataSystemResource() resource.Resource {
 
 // dataSystemResource is the resource implementation.
 type dataSystemResource struct {
-	client *neos.DataSystemClient
+	client      *neos.DataSystemClient
+	linksClient *neos.LinksClient
+	apiClient   *neosAPIClient
 }
 
 var (
@@ -111,6 +112,8 @@ func (r *dataSystemResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Required:    true,
 				Description: "list of links",
 			},
+			"deletion_protection": deletionProtectionAttribute("data system"),
+			"force_destroy":       forceDestroyAttribute("data system"),
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -120,16 +123,18 @@ func (r *dataSystemResource) Schema(_ context.Context, _ resource.SchemaRequest,
 
 // dataSystemResourceModel maps the resource schema data.
 type dataSystemResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	URN         types.String `tfsdk:"urn"`
-	Name        types.String `tfsdk:"name"`
-	Label       types.String `tfsdk:"label"`
-	Description types.String `tfsdk:"description"`
-	Owner       types.String `tfsdk:"owner"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	Links       types.List   `tfsdk:"links"`
-	ContactIds  types.List   `tfsdk:"contact_ids"`
-	LastUpdated types.String `tfsdk:"last_updated"`
+	ID                 types.String `tfsdk:"id"`
+	URN                types.String `tfsdk:"urn"`
+	Name               types.String `tfsdk:"name"`
+	Label              types.String `tfsdk:"label"`
+	Description        types.String `tfsdk:"description"`
+	Owner              types.String `tfsdk:"owner"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Links              types.List   `tfsdk:"links"`
+	ContactIds         types.List   `tfsdk:"contact_ids"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool   `tfsdk:"force_destroy"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
 }
 
 // Create a new resource.
@@ -230,8 +235,16 @@ func (r *dataSystemResource) Read(ctx context.Context, req resource.ReadRequest,
 			state.Label = types.StringValue(ds.Label)
 			state.URN = types.StringValue(ds.Urn)
 			state.Description = types.StringValue(ds.Description)
-			state.Owner = types.StringValue(ds.Owner)
 			state.CreatedAt = types.StringValue(ds.CreatedAt.String())
+
+			info, diags := readEntityInfo(ctx, r.apiClient, "data_system", ds.Identifier)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.Owner = info.Owner
+			state.ContactIds = info.ContactIds
+			state.Links = info.Links
 			break
 		}
 	}
@@ -342,6 +355,18 @@ func (r *dataSystemResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	if !checkDeletionProtection(&resp.Diagnostics, plan.DeletionProtection, "data system", plan.Name.ValueString()) {
+		return
+	}
+
+	if plan.ForceDestroy.ValueBool() {
+		err := deleteEntityLinks(ctx, r.linksClient, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting data system", "Could not remove the links of the data system, unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	err := r.client.Delete(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting data system", "Could not delete data system, unexpected error: "+err.Error())
@@ -355,7 +380,7 @@ func (r *dataSystemResource) Configure(_ context.Context, req resource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.DataSystemClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -363,10 +388,17 @@ func (r *dataSystemResource) Configure(_ context.Context, req resource.Configure
 	}
 
 	r.client = &client.DataSystemClient
+	r.linksClient = &client.LinksClient
+	r.apiClient = client.API
 
 }
 
+// ImportState imports the resource by its id, NEOS URN or name:<name>.
 func (r *dataSystemResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importCatalogueEntity(ctx, req, resp, "data system", func() ([]neos.DataSystem, error) {
+		list, err := r.client.Get()
+		return list.Entities, err
+	}, func(e neos.DataSystem) (string, string, string) {
+		return e.Identifier, e.Name, e.Urn
+	})
 }
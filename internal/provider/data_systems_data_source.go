@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewDataSystemsDataSource() datasource.DataSource {
+	return &dataSystemsDataSourceV2{}
+}
+
+var (
+	_ datasource.DataSource              = &dataSystemsDataSourceV2{}
+	_ datasource.DataSourceWithConfigure = &dataSystemsDataSourceV2{}
+)
+
+type dataSystemsDataSourceV2 struct {
+	client    *neos.DataSystemClient
+	apiClient *neosAPIClient
+}
+
+func (d *dataSystemsDataSourceV2) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_systems"
+}
+
+func (d *dataSystemsDataSourceV2) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "dataSystemsDataSourceV2 READ")
+
+	var state DataSystemsDataSourceModelV2
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, ok := nameRegexFilter(&resp.Diagnostics, state.NameRegex)
+	if !ok {
+		return
+	}
+
+	// The client lists the entities of the provider account.
+	var list neos.DataSystemList
+	var err error
+	if state.Account.IsNull() {
+		list, err = d.client.Get()
+	} else {
+		err = d.apiClient.EntityList("data_system", state.Account.ValueString(), &list)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data System List",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("dataSystemsDataSourceV2 READ length %d", len(list.Entities)))
+
+	matches := filterEntities(list.Entities, func(e neos.DataSystem) bool {
+		return nameRegex.MatchString(e.Name) && matchesFilter(state.Owner, e.Owner) && matchesFilter(state.Label, e.Label)
+	})
+
+	// Map response body to model
+	state.DataSystems = []DataSystemModelV2{}
+	for _, e := range matches {
+		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", e.Identifier))
+		state.DataSystems = append(state.DataSystems, newDataSystemModelV2(e))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (d *dataSystemsDataSourceV2) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "dataSystemsDataSourceV2 Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected dataSystemsDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+
+		return
+	}
+
+	d.client = &client.DataSystemClient
+	d.apiClient = client.API
+}
+
+func (d *dataSystemsDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account":    pluralFilterAttribute("Only return data systems of this account, defaults to the provider account"),
+			"name_regex": pluralFilterAttribute("Only return data systems whose name matches this regular expression"),
+			"owner":      pluralFilterAttribute("Only return data systems with this owner"),
+			"label":      pluralFilterAttribute("Only return data systems with this label"),
+			"data_systems": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The data systems matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: catalogueEntityDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+type DataSystemsDataSourceModelV2 struct {
+	Account     types.String        `tfsdk:"account"`
+	NameRegex   types.String        `tfsdk:"name_regex"`
+	Owner       types.String        `tfsdk:"owner"`
+	Label       types.String        `tfsdk:"label"`
+	DataSystems []DataSystemModelV2 `tfsdk:"data_systems"`
+}
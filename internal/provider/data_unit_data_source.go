@@ -16,8 +16,9 @@ func NewDataUnitDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &dataUnitDataSourceV2{}
-	_ datasource.DataSourceWithConfigure = &dataUnitDataSourceV2{}
+	_ datasource.DataSource                     = &dataUnitDataSourceV2{}
+	_ datasource.DataSourceWithConfigure        = &dataUnitDataSourceV2{}
+	_ datasource.DataSourceWithConfigValidators = &dataUnitDataSourceV2{}
 )
 
 type dataUnitDataSourceV2 struct {
@@ -30,49 +31,36 @@ func (d *dataUnitDataSourceV2) Metadata(_ context.Context, req datasource.Metada
 
 func (d *dataUnitDataSourceV2) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
-	tflog.Info(ctx, "Abi READ")
+	tflog.Info(ctx, "dataUnitDataSourceV2 READ")
 
-	var state DataUnitDataSourceModelV2
+	var config DataUnitModelV2
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.Get()
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read Data System List",
+			"Unable to Read Data Unit List",
 			err.Error(),
 		)
 		return
 	}
 
-	tflog.Info(ctx, "Abi READ Post error ")
-
-	tflog.Info(ctx, fmt.Sprintf("Abi READ length %d", len(list.Entities)))
-
-	// Map response body to model
-	// for _, ds := range list.Entities {
-
-	// 	configJson, err := d.client.ConfigTableGet(ctx, ds.Identifier)
-	// 	if err != nil {
-	// 		resp.Diagnostics.AddError(
-	// 			"Unable to Read Data System List",
-	// 			err.Error(),
-	// 		)
-	// 		return
-	// 	}
-	// 	dataUnitState := DataUnitModelV2{
-	// 		Identifier:  types.StringValue(ds.Identifier),
-	// 		Name:        types.StringValue(ds.Name),
-	// 		Description: types.StringValue(ds.Description),
-	// 		Label:       types.StringValue(ds.Label),
-	// 		Owner:       types.StringValue(ds.Owner),
-	// 		Urn:         types.StringValue(ds.Urn),
-	// 		ConfigJson:  types.StringValue(string(configJson)),
-	// 	}
-	// 	tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Identifier))
-	// 	state.Entities = append(state.Entities, dataUnitState)
-	// }
-
-	// Set state
-	diags := resp.State.Set(ctx, &state)
+	lookup := entityLookup{ID: config.Identifier, Name: config.Name, URN: config.Urn}
+	matches := filterEntities(list.Entities, func(e neos.DataUnit) bool {
+		return lookup.matches(e.Identifier, e.Name, e.Urn)
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "data unit", lookup.String(), len(matches)) {
+		return
+	}
+
+	state := newDataUnitModelV2(matches[0])
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -80,17 +68,16 @@ func (d *dataUnitDataSourceV2) Read(ctx context.Context, req datasource.ReadRequ
 
 }
 
-// Configure adds the provider configured client to the data source.
 func (d *dataUnitDataSourceV2) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
-	tflog.Info(ctx, "dataUnitDataSourceV2  Data source configure")
+	tflog.Info(ctx, "dataUnitDataSourceV2 Data source configure")
 
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected dataUnitDataSourceV2 Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected dataUnitDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 
 		return
 	}
@@ -98,63 +85,19 @@ func (d *dataUnitDataSourceV2) Configure(ctx context.Context, req datasource.Con
 	d.client = &client.DataUnitClient
 }
 
+func (d *dataUnitDataSourceV2) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
+}
+
 func (d *dataUnitDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := catalogueEntityDataSourceAttributes()
+	setLookupAttributes(attributes, "data unit", "id", "name", "urn")
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"entities": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"label": schema.StringAttribute{
-							Computed: true,
-						},
-						"created_at": schema.StringAttribute{
-							Computed: true,
-						},
-						"owner": schema.StringAttribute{
-							Computed: true,
-						},
-						"config_json": schema.StringAttribute{
-							Computed: true,
-							//Optional:    true,
-							//Required:    false,
-							//Description: "json that describes the configuration of the data unit",
-						},
-						"state": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"state": schema.StringAttribute{
-									Computed: true,
-								},
-								"healthy": schema.BoolAttribute{
-									Computed: true,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type DataUnitDataSourceModelV2 struct {
-	Entities []DataUnitModelV2 `tfsdk:"entities"`
-}
-
-// coffeesModel maps coffees schema data.
 type DataUnitModelV2 struct {
 	Identifier  types.String         `tfsdk:"id"`
 	Urn         types.String         `tfsdk:"urn"`
@@ -164,10 +107,25 @@ type DataUnitModelV2 struct {
 	Owner       types.String         `tfsdk:"owner"`
 	CreatedAt   types.String         `tfsdk:"created_at"`
 	State       DataUnitStateModelV2 `tfsdk:"state"`
-	ConfigJson  types.String         `tfsdk:"config_json"`
 }
 
 type DataUnitStateModelV2 struct {
 	State   types.String `tfsdk:"state"`
 	Healthy types.Bool   `tfsdk:"healthy"`
 }
+
+func newDataUnitModelV2(e neos.DataUnit) DataUnitModelV2 {
+	return DataUnitModelV2{
+		Identifier:  types.StringValue(e.Identifier),
+		Name:        types.StringValue(e.Name),
+		Description: types.StringValue(e.Description),
+		Label:       types.StringValue(e.Label),
+		Owner:       types.StringValue(e.Owner),
+		Urn:         types.StringValue(e.Urn),
+		CreatedAt:   types.StringValue(e.CreatedAt.String()),
+		State: DataUnitStateModelV2{
+			State:   types.StringValue(e.State.State),
+			Healthy: types.BoolValue(e.State.Healthy),
+		},
+	}
+}
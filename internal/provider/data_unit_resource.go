@@ -4,7 +4,6 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -27,7 +26,9 @@ func NewDataUnitResource() resource.Resource {
 
 // dataUnitResource is the resource implementation.
 type dataUnitResource struct {
-	client *neos.DataUnitClient
+	client      *neos.DataUnitClient
+	linksClient *neos.LinksClient
+	apiClient   *neosAPIClient
 }
 
 var (
@@ -256,6 +257,8 @@ func (r *dataUnitResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 				Required:    false,
 				Description: "list of links",
 			},
+			"deletion_protection": deletionProtectionAttribute("data unit"),
+			"force_destroy":       forceDestroyAttribute("data unit"),
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -265,17 +268,19 @@ func (r *dataUnitResource) Schema(_ context.Context, _ resource.SchemaRequest, r
 
 // dataUnitResourceModel maps the resource schema data.
 type dataUnitResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	URN         types.String `tfsdk:"urn"`
-	Name        types.String `tfsdk:"name"`
-	Label       types.String `tfsdk:"label"`
-	Description types.String `tfsdk:"description"`
-	Owner       types.String `tfsdk:"owner"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	Links       types.List   `tfsdk:"links"`
-	ContactIds  types.List   `tfsdk:"contact_ids"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	ConfigJson  types.String `tfsdk:"config_json"`
+	ID                 types.String `tfsdk:"id"`
+	URN                types.String `tfsdk:"urn"`
+	Name               types.String `tfsdk:"name"`
+	Label              types.String `tfsdk:"label"`
+	Description        types.String `tfsdk:"description"`
+	Owner              types.String `tfsdk:"owner"`
+	CreatedAt          types.String `tfsdk:"created_at"`
+	Links              types.List   `tfsdk:"links"`
+	ContactIds         types.List   `tfsdk:"contact_ids"`
+	DeletionProtection types.Bool   `tfsdk:"deletion_protection"`
+	ForceDestroy       types.Bool   `tfsdk:"force_destroy"`
+	LastUpdated        types.String `tfsdk:"last_updated"`
+	ConfigJson         types.String `tfsdk:"config_json"`
 	//Config      dataUnitConfigModel `tfsdk:"config"`
 }
 
@@ -549,7 +554,6 @@ func (r *dataUnitResource) Read(ctx context.Context, req resource.ReadRequest, r
 			state.Label = types.StringValue(ds.Label)
 			state.URN = types.StringValue(ds.Urn)
 			state.Description = types.StringValue(ds.Description)
-			state.Owner = types.StringValue(ds.Owner)
 			state.CreatedAt = types.StringValue(ds.CreatedAt.String())
 
 			// dataUnitConfig, err := r.client.ConfigGetBase(ctx, ds.Identifier)
@@ -569,6 +573,14 @@ func (r *dataUnitResource) Read(ctx context.Context, req resource.ReadRequest, r
 
 			// state.ConfigJson = types.StringValue(string(ordered))
 
+			info, diags := readEntityInfo(ctx, r.apiClient, "data_unit", ds.Identifier)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.Owner = info.Owner
+			state.ContactIds = orNull(state.ContactIds, info.ContactIds)
+			state.Links = orNull(state.Links, info.Links)
 			break
 		}
 	}
@@ -700,6 +712,18 @@ func (r *dataUnitResource) Delete(ctx context.Context, req resource.DeleteReques
 		return
 	}
 
+	if !checkDeletionProtection(&resp.Diagnostics, plan.DeletionProtection, "data unit", plan.Name.ValueString()) {
+		return
+	}
+
+	if plan.ForceDestroy.ValueBool() {
+		err := deleteEntityLinks(ctx, r.linksClient, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting data unit", "Could not remove the links of the data unit, unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	err := r.client.Delete(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting data unit", "Could not delete data unit, unexpected error: "+err.Error())
@@ -713,7 +737,7 @@ func (r *dataUnitResource) Configure(_ context.Context, req resource.ConfigureRe
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.DataUnitClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -721,10 +745,17 @@ func (r *dataUnitResource) Configure(_ context.Context, req resource.ConfigureRe
 	}
 
 	r.client = &client.DataUnitClient
+	r.linksClient = &client.LinksClient
+	r.apiClient = client.API
 
 }
 
+// ImportState imports the resource by its id, NEOS URN or name:<name>.
 func (r *dataUnitResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importCatalogueEntity(ctx, req, resp, "data unit", func() ([]neos.DataUnit, error) {
+		list, err := r.client.Get()
+		return list.Entities, err
+	}, func(e neos.DataUnit) (string, string, string) {
+		return e.Identifier, e.Name, e.Urn
+	})
 }
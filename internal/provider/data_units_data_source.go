@@ -0,0 +1,127 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewDataUnitsDataSource() datasource.DataSource {
+	return &dataUnitsDataSourceV2{}
+}
+
+var (
+	_ datasource.DataSource              = &dataUnitsDataSourceV2{}
+	_ datasource.DataSourceWithConfigure = &dataUnitsDataSourceV2{}
+)
+
+type dataUnitsDataSourceV2 struct {
+	client    *neos.DataUnitClient
+	apiClient *neosAPIClient
+}
+
+func (d *dataUnitsDataSourceV2) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_data_units"
+}
+
+func (d *dataUnitsDataSourceV2) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "dataUnitsDataSourceV2 READ")
+
+	var state DataUnitsDataSourceModelV2
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, ok := nameRegexFilter(&resp.Diagnostics, state.NameRegex)
+	if !ok {
+		return
+	}
+
+	// The client lists the entities of the provider account.
+	var list neos.DataUnitList
+	var err error
+	if state.Account.IsNull() {
+		list, err = d.client.Get()
+	} else {
+		err = d.apiClient.EntityList("data_unit", state.Account.ValueString(), &list)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Data Unit List",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("dataUnitsDataSourceV2 READ length %d", len(list.Entities)))
+
+	matches := filterEntities(list.Entities, func(e neos.DataUnit) bool {
+		return nameRegex.MatchString(e.Name) && matchesFilter(state.Owner, e.Owner) && matchesFilter(state.Label, e.Label)
+	})
+
+	// Map response body to model
+	state.DataUnits = []DataUnitModelV2{}
+	for _, e := range matches {
+		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", e.Identifier))
+		state.DataUnits = append(state.DataUnits, newDataUnitModelV2(e))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (d *dataUnitsDataSourceV2) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "dataUnitsDataSourceV2 Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected dataUnitsDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+
+		return
+	}
+
+	d.client = &client.DataUnitClient
+	d.apiClient = client.API
+}
+
+func (d *dataUnitsDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account":    pluralFilterAttribute("Only return data units of this account, defaults to the provider account"),
+			"name_regex": pluralFilterAttribute("Only return data units whose name matches this regular expression"),
+			"owner":      pluralFilterAttribute("Only return data units with this owner"),
+			"label":      pluralFilterAttribute("Only return data units with this label"),
+			"data_units": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The data units matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: catalogueEntityDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+type DataUnitsDataSourceModelV2 struct {
+	Account   types.String      `tfsdk:"account"`
+	NameRegex types.String      `tfsdk:"name_regex"`
+	Owner     types.String      `tfsdk:"owner"`
+	Label     types.String      `tfsdk:"label"`
+	DataUnits []DataUnitModelV2 `tfsdk:"data_units"`
+}
@@ -0,0 +1,69 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+// forceDestroyAttribute is the force_destroy argument of the catalogue entity
+// resources.
+func forceDestroyAttribute(entityType string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Computed:    false,
+		Required:    false,
+		Optional:    true,
+		Description: fmt.Sprintf("When true every link to and from the %s is removed before it is deleted, including links made outside Terraform. It must be set in an apply before the destroy", entityType),
+	}
+}
+
+// deletionProtectionAttribute is the deletion_protection argument of the
+// catalogue entity resources.
+func deletionProtectionAttribute(entityType string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Computed:    false,
+		Required:    false,
+		Optional:    true,
+		Description: fmt.Sprintf("When true the %s can not be deleted or replaced, it must be set to false in an apply before the destroy", entityType),
+	}
+}
+
+// checkDeletionProtection adds an error to diags when deletion protection is
+// on for the entity.
+func checkDeletionProtection(diags *diag.Diagnostics, protected types.Bool, entityType string, name string) bool {
+	if !protected.ValueBool() {
+		return true
+	}
+	diags.AddError(
+		fmt.Sprintf("Cannot delete protected %s", entityType),
+		fmt.Sprintf("The %s %q has deletion_protection set. Set deletion_protection to false and apply before destroying or replacing it.", entityType, name),
+	)
+	return false
+}
+
+// deleteEntityLinks removes every link the entity is the parent or child of.
+func deleteEntityLinks(ctx context.Context, client *neos.LinksClient, id string) error {
+	list, err := client.Get()
+	if err != nil {
+		return err
+	}
+
+	for _, l := range list.Links {
+		if l.Parent.Identifier != id && l.Child.Identifier != id {
+			continue
+		}
+
+		tflog.Info(ctx, fmt.Sprintf("deleteEntityLinks %s [%s] -> %s [%s]", l.Parent.EntityType, l.Parent.Identifier, l.Child.EntityType, l.Child.Identifier))
+		err := client.Delete(ctx, l.Parent.EntityType, l.Child.EntityType, l.Parent.Identifier, l.Child.Identifier)
+		// The link may already have been removed with the other entity.
+		if err != nil && !isNotFoundError(err) {
+			return fmt.Errorf("removing the link from %s %s to %s %s: %w", l.Parent.EntityType, l.Parent.Name, l.Child.EntityType, l.Child.Name, err)
+		}
+	}
+	return nil
+}
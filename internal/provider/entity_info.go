@@ -0,0 +1,55 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// entityInfoModel is the owner, contact_ids and links of a catalogue entity
+// resource.
+type entityInfoModel struct {
+	Owner      types.String
+	ContactIds types.List
+	Links      types.List
+}
+
+// readEntityInfo reads the info of the entity, an entity without contacts or
+// links has empty lists rather than null ones.
+func readEntityInfo(ctx context.Context, client *neosAPIClient, entityType string, id string) (entityInfoModel, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	info, err := client.EntityInfoGet(entityType, id)
+	if err != nil {
+		diags.AddError("Error Reading NEOS "+entityTypeDescription(entityType)+" info", "Could not read NEOS "+entityTypeDescription(entityType)+" info ID "+id+": "+err.Error())
+		return entityInfoModel{}, diags
+	}
+
+	if info.ContactIds == nil {
+		info.ContactIds = []string{}
+	}
+	if info.Links == nil {
+		info.Links = []string{}
+	}
+
+	contactIds, d := types.ListValueFrom(ctx, types.StringType, info.ContactIds)
+	diags.Append(d...)
+	links, d := types.ListValueFrom(ctx, types.StringType, info.Links)
+	diags.Append(d...)
+
+	return entityInfoModel{
+		Owner:      types.StringValue(info.Owner),
+		ContactIds: contactIds,
+		Links:      links,
+	}, diags
+}
+
+// orNull keeps a null list null when the entity has no items, for the optional
+// lists that may be left out of the configuration.
+func orNull(current types.List, read types.List) types.List {
+	if current.IsNull() && len(read.Elements()) == 0 {
+		return current
+	}
+	return read
+}
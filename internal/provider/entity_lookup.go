@@ -0,0 +1,171 @@
+package provider
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// filterEntities returns the entities that keep returns true for.
+func filterEntities[T any](entities []T, keep func(T) bool) []T {
+	rtn := []T{}
+	for _, e := range entities {
+		if keep(e) {
+			rtn = append(rtn, e)
+		}
+	}
+	return rtn
+}
+
+// expectSingleEntity adds an error to diags unless a singular lookup matched
+// exactly one entity.
+func expectSingleEntity(diags *diag.Diagnostics, entityType string, lookup string, matches int) bool {
+	switch {
+	case matches == 0:
+		diags.AddError(
+			fmt.Sprintf("No matching %s", entityType),
+			fmt.Sprintf("No %s matched %s.", entityType, lookup),
+		)
+		return false
+	case matches > 1:
+		diags.AddError(
+			fmt.Sprintf("Multiple matching %s", entityType),
+			fmt.Sprintf("%d %s entities matched %s, a singular lookup must match exactly one. Use the id to select one.", matches, entityType, lookup),
+		)
+		return false
+	}
+	return true
+}
+
+// entityLookup holds the id, name and urn a singular data source looks up an
+// entity by, exactly one of them is set.
+type entityLookup struct {
+	ID   types.String
+	Name types.String
+	URN  types.String
+}
+
+// matches reports if the entity with the given id, name and urn is the one
+// being looked up.
+func (l entityLookup) matches(id string, name string, urn string) bool {
+	switch {
+	case !l.ID.IsNull():
+		return id == l.ID.ValueString()
+	case !l.URN.IsNull():
+		return urn == l.URN.ValueString()
+	default:
+		return name == l.Name.ValueString()
+	}
+}
+
+func (l entityLookup) String() string {
+	switch {
+	case !l.ID.IsNull():
+		return fmt.Sprintf("id %q", l.ID.ValueString())
+	case !l.URN.IsNull():
+		return fmt.Sprintf("urn %q", l.URN.ValueString())
+	default:
+		return fmt.Sprintf("name %q", l.Name.ValueString())
+	}
+}
+
+// nameRegexFilter compiles the name_regex filter of a plural data source, a
+// null filter matches every name.
+func nameRegexFilter(diags *diag.Diagnostics, nameRegex types.String) (*regexp.Regexp, bool) {
+	if nameRegex.IsNull() {
+		return regexp.MustCompile(".*"), true
+	}
+
+	rtn, err := regexp.Compile(nameRegex.ValueString())
+	if err != nil {
+		diags.AddAttributeError(path.Root("name_regex"), "Invalid name_regex", "The name_regex is not a valid regular expression: "+err.Error())
+		return nil, false
+	}
+	return rtn, true
+}
+
+// matchesFilter reports if value passes an exact match filter, a null filter
+// matches every value.
+func matchesFilter(filter types.String, value string) bool {
+	return filter.IsNull() || filter.ValueString() == value
+}
+
+// catalogueEntityDataSourceAttributes are the computed attributes shared by the
+// data system, data source, data unit, data product and output data sources.
+func catalogueEntityDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"urn": schema.StringAttribute{
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"label": schema.StringAttribute{
+			Computed: true,
+		},
+		"created_at": schema.StringAttribute{
+			Computed: true,
+		},
+		"owner": schema.StringAttribute{
+			Computed: true,
+		},
+		"state": schema.SingleNestedAttribute{
+			Computed: true,
+			Attributes: map[string]schema.Attribute{
+				"state": schema.StringAttribute{
+					Computed: true,
+				},
+				"healthy": schema.BoolAttribute{
+					Computed: true,
+				},
+			},
+		},
+	}
+}
+
+// setLookupAttributes makes the attributes a singular data source looks up by
+// optional, the matching ConfigValidators require exactly one of them.
+func setLookupAttributes(attributes map[string]schema.Attribute, entityType string, lookupBy ...string) {
+	for _, name := range lookupBy {
+		attributes[name] = schema.StringAttribute{
+			Computed:    true,
+			Optional:    true,
+			Required:    false,
+			Description: fmt.Sprintf("The %s of the %s to look up, exactly one of %s must be set", name, entityType, strings.Join(lookupBy, ", ")),
+		}
+	}
+}
+
+// lookupConfigValidators requires exactly one of the lookup attributes to be set.
+func lookupConfigValidators(lookupBy ...string) []datasource.ConfigValidator {
+	expressions := []path.Expression{}
+	for _, name := range lookupBy {
+		expressions = append(expressions, path.MatchRoot(name))
+	}
+	return []datasource.ConfigValidator{
+		datasourcevalidator.ExactlyOneOf(expressions...),
+	}
+}
+
+// pluralFilterAttribute is an optional filter argument of a plural data source.
+func pluralFilterAttribute(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		Computed:    false,
+		Optional:    true,
+		Required:    false,
+		Description: description,
+	}
+}
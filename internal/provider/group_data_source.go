@@ -16,8 +16,9 @@ func NewGroupDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &groupDataSource{}
-	_ datasource.DataSourceWithConfigure = &groupDataSource{}
+	_ datasource.DataSource                     = &groupDataSource{}
+	_ datasource.DataSourceWithConfigure        = &groupDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &groupDataSource{}
 )
 
 type groupDataSource struct {
@@ -30,31 +31,32 @@ func (d *groupDataSource) Metadata(_ context.Context, req datasource.MetadataReq
 
 func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
 
-	var state GroupDataSourceModel
+	var config GroupModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	list, err := d.client.List("")
+	list, err := d.client.List(config.Account.ValueString())
 	if err != nil {
-		resp.Diagnostics.AddError("Unable to Read Data System List", err.Error())
+		resp.Diagnostics.AddError("Unable to Read Group List", err.Error())
 		return
 	}
 
-	// Map response body to model
-	for _, ds := range list.Groups {
-		group := GroupModel{
-			Identifier:  types.StringValue(ds.Identifier),
-			Name:        types.StringValue(ds.Name),
-			Description: types.StringValue(ds.Description),
-			IsSystem:    types.BoolValue(ds.IsSystem),
-		}
-		for _, v := range ds.Principals {
-			group.Principals = append(group.Principals, types.StringValue(v))
-		}
-		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Identifier))
-		state.GroupModel = append(state.GroupModel, group)
+	// groups do not have a urn so they can only be looked up by id or name
+	lookup := entityLookup{ID: config.Identifier, Name: config.Name, URN: types.StringNull()}
+	matches := filterEntities(list.Groups, func(g neos.Group) bool {
+		return lookup.matches(g.Identifier, g.Name, "")
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "group", lookup.String(), len(matches)) {
+		return
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
+	state := newGroupModel(matches[0], config.Account)
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -62,7 +64,6 @@ func (d *groupDataSource) Read(ctx context.Context, req datasource.ReadRequest,
 
 }
 
-// Configure adds the provider configured client to the data source.
 func (d *groupDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	tflog.Info(ctx, "Data source configure")
 
@@ -70,57 +71,78 @@ func (d *groupDataSource) Configure(ctx context.Context, req datasource.Configur
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected groupDataSource Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected groupDataSource Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
 	}
 
 	d.client = &client.GroupClient
 }
 
+func (d *groupDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name")
+}
+
 func (d *groupDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := groupDataSourceAttributes()
+	setLookupAttributes(attributes, "group", "id", "name")
+	attributes["account"] = pluralFilterAttribute("The account the group is in")
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"groups": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"is_system": schema.BoolAttribute{
-							Computed: true,
-						},
-						"principals": schema.ListAttribute{
-							ElementType: types.StringType,
-							Computed:    false,
-							Optional:    true,
-							Required:    false,
-							Description: "list of principals",
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type GroupDataSourceModel struct {
-	GroupModel []GroupModel `tfsdk:"group"`
+// groupDataSourceAttributes are the computed attributes of a group shared by
+// the neos_group and neos_groups data sources.
+func groupDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"description": schema.StringAttribute{
+			Computed: true,
+		},
+		"is_system": schema.BoolAttribute{
+			Computed: true,
+		},
+		"account": schema.StringAttribute{
+			Computed: true,
+		},
+		"principals": schema.ListAttribute{
+			ElementType: types.StringType,
+			Computed:    true,
+			Optional:    false,
+			Required:    false,
+			Description: "list of principals",
+		},
+	}
 }
 
-// coffeesModel maps coffees schema data.
 type GroupModel struct {
 	Identifier  types.String   `tfsdk:"id"`
 	Description types.String   `tfsdk:"description"`
 	Name        types.String   `tfsdk:"name"`
 	IsSystem    types.Bool     `tfsdk:"is_system"`
-	Principals  []types.String `tfsdk:"printcipals"`
+	Account     types.String   `tfsdk:"account"`
+	Principals  []types.String `tfsdk:"principals"`
+}
+
+func newGroupModel(g neos.Group, account types.String) GroupModel {
+	rtn := GroupModel{
+		Identifier:  types.StringValue(g.Identifier),
+		Name:        types.StringValue(g.Name),
+		Description: types.StringValue(g.Description),
+		IsSystem:    types.BoolValue(g.IsSystem),
+		Account:     account,
+		Principals:  []types.String{},
+	}
+	for _, v := range g.Principals {
+		rtn.Principals = append(rtn.Principals, types.StringValue(v))
+	}
+	return rtn
 }
@@ -0,0 +1,301 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+	"golang.org/x/exp/slices"
+)
+
+// NewGroupMembersResource is a helper function to simplify the provider implementation.
+func NewGroupMembersResource() resource.Resource {
+	return &groupMembersResource{}
+}
+
+// groupMembersResource adds a set of principals to a group, members added by
+// anything else are left alone.
+type groupMembersResource struct {
+	client          *neos.GroupClient
+	providerAccount string
+}
+
+var (
+	_ resource.Resource                = &groupMembersResource{}
+	_ resource.ResourceWithConfigure   = &groupMembersResource{}
+	_ resource.ResourceWithModifyPlan  = &groupMembersResource{}
+	_ resource.ResourceWithImportState = &groupMembersResource{}
+)
+
+// Metadata returns the resource type name.
+func (r *groupMembersResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_members"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupMembersResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The ID of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The ID of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principals": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    false,
+				Optional:    false,
+				Required:    true,
+				Description: "The IDs of the users or principals this resource adds to the group, other members of the group are not removed",
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
+			},
+		},
+	}
+}
+
+// groupMembersResourceModel maps the resource schema data.
+type groupMembersResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	GroupID    types.String `tfsdk:"group_id"`
+	Principals types.Set    `tfsdk:"principals"`
+	Account    types.String `tfsdk:"account"`
+}
+
+// Create a new resource.
+func (r *groupMembersResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupMembersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, principals := SortListValueIntoStringArray(ctx, plan.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncMembers(ctx, plan.GroupID.ValueString(), plan.Account.ValueString(), principals, []string{})
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating group members", "Could not add principals to group, unexpected error: "+err.Error())
+		return
+	}
+
+	plan.ID = plan.GroupID
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data, only the principals
+// this resource manages are kept.
+func (r *groupMembersResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupMembersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+
+	grp, err := r.client.Get(state.GroupID.ValueString(), state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading NEOS group", "Could not read NEOS group ID "+state.GroupID.ValueString()+": "+err.Error())
+		return
+	}
+
+	diags, managed := SortListValueIntoStringArray(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	members := []string{}
+	for _, p := range managed {
+		if slices.Contains(grp.Principals, p) {
+			members = append(members, p)
+		}
+	}
+
+	state.Principals, diags = SortStringArrayToList(members)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update adds the new principals and removes the ones no longer in the plan.
+func (r *groupMembersResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupMembersResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state groupMembersResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, wanted := SortListValueIntoStringArray(ctx, plan.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, previous := SortListValueIntoStringArray(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncMembers(ctx, plan.GroupID.ValueString(), plan.Account.ValueString(), wanted, previous)
+	if err != nil {
+		resp.Diagnostics.AddError("Error updating group members", "Could not update group principals, unexpected error: "+err.Error())
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete removes the principals this resource added from the group.
+func (r *groupMembersResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupMembersResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, previous := SortListValueIntoStringArray(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.syncMembers(ctx, state.GroupID.ValueString(), state.Account.ValueString(), []string{}, previous)
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting group members", "Could not remove principals from group, unexpected error: "+err.Error())
+		return
+	}
+}
+
+// syncMembers adds the wanted principals that are not yet in the group and
+// removes the previously managed principals that are no longer wanted.
+func (r *groupMembersResource) syncMembers(ctx context.Context, groupID string, account string, wanted []string, previous []string) error {
+	grp, err := r.client.Get(groupID, account)
+	if err != nil {
+		return err
+	}
+
+	addList := []string{}
+	for _, p := range wanted {
+		if !slices.Contains(grp.Principals, p) {
+			addList = append(addList, p)
+		}
+	}
+
+	delList := []string{}
+	for _, p := range previous {
+		if !slices.Contains(wanted, p) && slices.Contains(grp.Principals, p) {
+			delList = append(delList, p)
+		}
+	}
+
+	if len(addList) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("adding %v to group %s", addList, groupID))
+		_, err = r.client.PrincipalsPost(ctx, groupID, neos.GroupPrincipalPostRequest{Principals: addList}, account)
+		if err != nil {
+			return err
+		}
+	}
+
+	if len(delList) > 0 {
+		tflog.Info(ctx, fmt.Sprintf("removing %v from group %s", delList, groupID))
+		_, err = r.client.PrincipalsDelete(ctx, groupID, neos.GroupPrincipalDeleteRequest{Principals: delList}, account)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (r *groupMembersResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Group Members Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = &client.GroupClient
+	r.providerAccount = client.Account
+}
+
+// ModifyPlan defaults the account to the provider's.
+func (r *groupMembersResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
+}
+
+// ImportState imports the members of a group from <group_id>,
+// <account>/<group_id> or the NEOS URN of the group. No principals are
+// managed after the import, the next apply takes over the ones in the
+// configuration and leaves the other members alone.
+func (r *groupMembersResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	importAccountScoped(ctx, req, resp, "group_id", false, nil)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var groupID types.String
+	resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root("group_id"), &groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), groupID)...)
+}
@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+	"golang.org/x/exp/slices"
+)
+
+// NewGroupMembershipResource is a helper function to simplify the provider implementation.
+func NewGroupMembershipResource() resource.Resource {
+	return &groupMembershipResource{}
+}
+
+// groupMembershipResource adds a single principal to a group without touching
+// the other members.
+type groupMembershipResource struct {
+	client          *neos.GroupClient
+	providerAccount string
+}
+
+var (
+	_ resource.Resource                = &groupMembershipResource{}
+	_ resource.ResourceWithConfigure   = &groupMembershipResource{}
+	_ resource.ResourceWithModifyPlan  = &groupMembershipResource{}
+	_ resource.ResourceWithImportState = &groupMembershipResource{}
+)
+
+// Metadata returns the resource type name.
+func (r *groupMembershipResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_group_membership"
+}
+
+// Schema defines the schema for the resource.
+func (r *groupMembershipResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The ID of the membership in the form <group_id>/<principal_id>",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"group_id": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The ID of the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"principal_id": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The ID of the user or principal to add to the group",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
+			},
+		},
+	}
+}
+
+// groupMembershipResourceModel maps the resource schema data.
+type groupMembershipResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	GroupID     types.String `tfsdk:"group_id"`
+	PrincipalID types.String `tfsdk:"principal_id"`
+	Account     types.String `tfsdk:"account"`
+}
+
+// Create a new resource.
+func (r *groupMembershipResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan groupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gppr := neos.GroupPrincipalPostRequest{Principals: []string{plan.PrincipalID.ValueString()}}
+	_, err := r.client.PrincipalsPost(ctx, plan.GroupID.ValueString(), gppr, plan.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error creating group membership", "Could not add principal to group, unexpected error: "+err.Error())
+		return
+	}
+
+	plan.ID = types.StringValue(fmt.Sprintf("%s/%s", plan.GroupID.ValueString(), plan.PrincipalID.ValueString()))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data.
+func (r *groupMembershipResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state groupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+
+	grp, err := r.client.Get(state.GroupID.ValueString(), state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error Reading NEOS group", "Could not read NEOS group ID "+state.GroupID.ValueString()+": "+err.Error())
+		return
+	}
+
+	if !slices.Contains(grp.Principals, state.PrincipalID.ValueString()) {
+		tflog.Info(ctx, fmt.Sprintf("principal %s is no longer a member of group %s", state.PrincipalID.ValueString(), state.GroupID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called with changes as every attribute requires replacement.
+func (r *groupMembershipResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan groupMembershipResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *groupMembershipResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state groupMembershipResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	gpdr := neos.GroupPrincipalDeleteRequest{Principals: []string{state.PrincipalID.ValueString()}}
+	_, err := r.client.PrincipalsDelete(ctx, state.GroupID.ValueString(), gpdr, state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting group membership", "Could not remove principal from group, unexpected error: "+err.Error())
+		return
+	}
+}
+
+func (r *groupMembershipResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Group Membership Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = &client.GroupClient
+	r.providerAccount = client.Account
+}
+
+// ModifyPlan defaults the account to the provider's.
+func (r *groupMembershipResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
+}
+
+// ImportState imports a membership from <group_id>/<principal_id> or
+// <account>/<group_id>/<principal_id>, the group and principal may be given as
+// NEOS URNs.
+func (r *groupMembershipResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	ids, account, err := parseImportIDParts(req.ID, "<group_id>/<principal_id>", 2)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return
+	}
+	groupID, principalID := ids[0].ID, ids[1].ID
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), fmt.Sprintf("%s/%s", groupID, principalID))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("group_id"), groupID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principal_id"), principalID)...)
+	if account != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), account)...)
+	}
+}
@@ -7,7 +7,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -26,13 +25,15 @@ func NewGroupResource() resource.Resource {
 
 // groupResource is the resource implementation.
 type groupResource struct {
-	client *neos.GroupClient
+	client          *neos.GroupClient
+	providerAccount string
 }
 
 var (
 	_ resource.Resource                = &groupResource{}
 	_ resource.ResourceWithConfigure   = &groupResource{}
 	_ resource.ResourceWithImportState = &groupResource{}
+	_ resource.ResourceWithModifyPlan  = &groupResource{}
 )
 
 // Metadata returns the resource type name.
@@ -73,7 +74,7 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed:    false,
 				Optional:    true,
 				Required:    false,
-				Description: "list of principals",
+				Description: "list of principals, when set the group owns its whole membership and removes any other members. Leave unset to manage members with neos_group_membership or neos_group_members",
 			},
 			"is_system": schema.BoolAttribute{
 				Computed:    true,
@@ -85,10 +86,10 @@ func (r *groupResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				Computed: true,
 			},
 			"account": schema.StringAttribute{
-				Computed:    false,
+				Computed:    true,
 				Optional:    true,
 				Required:    false,
-				Description: "account if not root",
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
 			},
 		},
 	}
@@ -147,26 +148,28 @@ func (r *groupResource) Create(ctx context.Context, req resource.CreateRequest,
 	plan.IsSystem = types.BoolValue(result.IsSystem)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
-	gppr := neos.GroupPrincipalPostRequest{}
-
-	diags, gppr.Principals = SortListValueIntoStringArray(ctx, plan.Principals)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
-	}
+	if !plan.Principals.IsNull() {
+		gppr := neos.GroupPrincipalPostRequest{}
 
-	if len(gppr.Principals) > 0 {
-		_, err := r.client.PrincipalsPost(ctx, plan.ID.ValueString(), gppr, plan.Account.ValueString())
-		if err != nil {
-			resp.Diagnostics.AddError("Error creating   group Principals", "Could not create group Principals, unexpected error: "+err.Error())
+		diags, gppr.Principals = SortListValueIntoStringArray(ctx, plan.Principals)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
 			return
 		}
-	}
 
-	plan.Principals, diags = SortStringArrayToList(gppr.Principals)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
-		return
+		if len(gppr.Principals) > 0 {
+			_, err := r.client.PrincipalsPost(ctx, plan.ID.ValueString(), gppr, plan.Account.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Error creating   group Principals", "Could not create group Principals, unexpected error: "+err.Error())
+				return
+			}
+		}
+
+		plan.Principals, diags = SortStringArrayToList(gppr.Principals)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	diags = resp.State.Set(ctx, plan)
@@ -187,6 +190,8 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+
 	foo := fmt.Sprintf("ID [%s]  Desc [%s]", state.ID.ValueString(), state.Description.ValueString())
 	tflog.Info(ctx, foo)
 
@@ -202,11 +207,14 @@ func (r *groupResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			state.Name = types.StringValue(ds.Name)
 			state.Description = types.StringValue(ds.Description)
 			state.IsSystem = types.BoolValue(ds.IsSystem)
-			state.Principals, diags = SortStringArrayToList(ds.Principals)
-			resp.Diagnostics.Append(diags...)
-			if resp.Diagnostics.HasError() {
-				tflog.Info(ctx, "group Read Has error")
-				return
+			// members are only refreshed when the group owns them
+			if !state.Principals.IsNull() {
+				state.Principals, diags = SortStringArrayToList(ds.Principals)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					tflog.Info(ctx, "group Read Has error")
+					return
+				}
 			}
 			break
 		}
@@ -248,6 +256,12 @@ func (r *groupResource) Update(ctx context.Context, req resource.UpdateRequest,
 
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
 
+	if plan.Principals.IsNull() {
+		diags = resp.State.Set(ctx, plan)
+		resp.Diagnostics.Append(diags...)
+		return
+	}
+
 	grp, err := r.client.Get(plan.ID.ValueString(), plan.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error gettting group", "Could not get group to workout principals, unexpected error: "+err.Error())
@@ -293,6 +307,10 @@ func (r *groupResource) deleteUsersFromGroup(ctx context.Context, plan groupReso
 		}
 	}
 
+	if len(delList) == 0 {
+		return false
+	}
+
 	g, err := r.client.PrincipalsDelete(ctx, plan.ID.ValueString(), neos.GroupPrincipalDeleteRequest{Principals: delList}, plan.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting principals", "Could not delete principals, unexpected error: "+err.Error())
@@ -321,10 +339,14 @@ func (r *groupResource) addUsersToGroup(ctx context.Context, plan groupResourceM
 
 		} else {
 
-			addList = append(grp.Principals, planP)
+			addList = append(addList, planP)
 		}
 	}
 
+	if len(addList) == 0 {
+		return false
+	}
+
 	gg, err := r.client.PrincipalsPost(ctx, plan.ID.ValueString(), neos.GroupPrincipalPostRequest{Principals: addList}, plan.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error posting principals", "Could not post to update principals, unexpected error: "+err.Error())
@@ -359,7 +381,7 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Group Configure Type", fmt.Sprintf("Expected *neos.GroupClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -367,10 +389,16 @@ func (r *groupResource) Configure(_ context.Context, req resource.ConfigureReque
 	}
 
 	r.client = &client.GroupClient
+	r.providerAccount = client.Account
+
+}
 
+// ModifyPlan defaults the account to the provider's.
+func (r *groupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
 }
 
+// ImportState imports a group from <id>, <account>/<id> or its NEOS URN.
 func (r *groupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importAccountScoped(ctx, req, resp, "id", false, nil)
 }
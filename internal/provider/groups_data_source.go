@@ -0,0 +1,105 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewGroupsDataSource() datasource.DataSource {
+	return &groupsDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &groupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &groupsDataSource{}
+)
+
+type groupsDataSource struct {
+	client *neos.GroupClient
+}
+
+func (d *groupsDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_groups"
+}
+
+func (d *groupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state GroupsDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, ok := nameRegexFilter(&resp.Diagnostics, state.NameRegex)
+	if !ok {
+		return
+	}
+
+	list, err := d.client.List(state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Group List", err.Error())
+		return
+	}
+
+	matches := filterEntities(list.Groups, func(g neos.Group) bool {
+		return nameRegex.MatchString(g.Name)
+	})
+
+	state.Groups = []GroupModel{}
+	for _, g := range matches {
+		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", g.Identifier))
+		state.Groups = append(state.Groups, newGroupModel(g, state.Account))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (d *groupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected groupsDataSource Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = &client.GroupClient
+}
+
+func (d *groupsDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": pluralFilterAttribute("Only return groups whose name matches this regular expression"),
+			"account":    pluralFilterAttribute("Only return groups in this account"),
+			"groups": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The groups matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: groupDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+type GroupsDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Account   types.String `tfsdk:"account"`
+	Groups    []GroupModel `tfsdk:"groups"`
+}
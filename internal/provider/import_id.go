@@ -0,0 +1,214 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+)
+
+// neosURN is a parsed NEOS resource name, for example
+// nrn:ksa:iam::root:account:root is
+// nrn:<partition>:<service>:<region>:<account>:<type>:<id>.
+type neosURN struct {
+	Partition string
+	Service   string
+	Account   string
+	Type      string
+	ID        string
+}
+
+// parseNeosURN parses a NEOS resource name, ok is false when s is not one.
+func parseNeosURN(s string) (neosURN, bool) {
+	parts := strings.Split(s, ":")
+	if len(parts) < 7 || parts[0] != "nrn" {
+		return neosURN{}, false
+	}
+	return neosURN{
+		Partition: parts[1],
+		Service:   parts[2],
+		Account:   parts[4],
+		Type:      parts[5],
+		ID:        strings.Join(parts[6:], ":"),
+	}, true
+}
+
+// importID is a parsed import ID, one of <id>, <account>/<id> or a NEOS URN.
+type importID struct {
+	ID        string
+	Account   string
+	Partition string
+	URN       string
+}
+
+// parseImportID parses an import ID, the <account>/<id> form is only accepted
+// for account-scoped resources.
+func parseImportID(raw string, accountScoped bool) (importID, error) {
+	if strings.HasPrefix(raw, "nrn:") {
+		urn, ok := parseNeosURN(raw)
+		if !ok || urn.ID == "" {
+			return importID{}, fmt.Errorf("%q is not a valid NEOS URN, expected nrn:<partition>:<service>:<region>:<account>:<type>:<id>", raw)
+		}
+		return importID{ID: urn.ID, Account: urn.Account, Partition: urn.Partition, URN: raw}, nil
+	}
+
+	if account, id, found := strings.Cut(raw, "/"); found {
+		if !accountScoped {
+			return importID{}, fmt.Errorf("expected an import ID of <id> or a NEOS URN, got: %q", raw)
+		}
+		if account == "" || id == "" {
+			return importID{}, fmt.Errorf("expected an import ID of <id>, <account>/<id> or a NEOS URN, got: %q", raw)
+		}
+		return importID{ID: id, Account: account}, nil
+	}
+
+	if raw == "" {
+		return importID{}, fmt.Errorf("the import ID is empty")
+	}
+	return importID{ID: raw}, nil
+}
+
+// parseImportIDParts parses an import ID made of n parts separated by / with
+// an optional leading <account>/, form describes the parts for the error. Each
+// part may be a NEOS URN, the account is taken from the prefix and otherwise
+// from the first URN that has one.
+func parseImportIDParts(raw string, form string, n int) ([]importID, string, error) {
+	invalid := fmt.Errorf("expected an import ID of %s, <account>/%s or with NEOS URNs for the IDs, got: %q", form, form, raw)
+
+	parts := strings.Split(raw, "/")
+	account := ""
+	if len(parts) == n+1 {
+		account, parts = parts[0], parts[1:]
+		if account == "" {
+			return nil, "", invalid
+		}
+	}
+	if len(parts) != n {
+		return nil, "", invalid
+	}
+
+	ids := make([]importID, 0, n)
+	for _, part := range parts {
+		id, err := parseImportID(part, false)
+		if err != nil {
+			if part == "" {
+				return nil, "", invalid
+			}
+			return nil, "", err
+		}
+		if account == "" {
+			account = id.Account
+		}
+		ids = append(ids, id)
+	}
+	return ids, account, nil
+}
+
+// urnLookup resolves the URN of an entity in an account to the value its
+// resource is imported by, found is false when no entity has the URN.
+type urnLookup func(urn string, account string) (value string, found bool, err error)
+
+// importByIDOrURN imports a resource from its id or NEOS URN into idAttr,
+// lookup resolves a URN to the id, when it is nil the id is taken from the
+// URN.
+func importByIDOrURN(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, idAttr string, lookup urnLookup) {
+	id, ok := resolveImportID(req.ID, false, lookup, resp)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttr), id.ID)...)
+}
+
+// importAccountScoped imports an account-scoped resource from <id>,
+// <account>/<id> or its NEOS URN. The account, and the partition when
+// withPartition is set, are taken from the import ID, otherwise the first
+// Read falls back to the provider's.
+func importAccountScoped(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, idAttr string, withPartition bool, lookup urnLookup) {
+	id, ok := resolveImportID(req.ID, true, lookup, resp)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(idAttr), id.ID)...)
+	if id.Account != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), id.Account)...)
+	}
+	if withPartition && id.Partition != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("partition"), id.Partition)...)
+	}
+}
+
+func resolveImportID(raw string, accountScoped bool, lookup urnLookup, resp *resource.ImportStateResponse) (importID, bool) {
+	id, err := parseImportID(raw, accountScoped)
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid import ID", err.Error())
+		return id, false
+	}
+
+	if id.URN == "" || lookup == nil {
+		return id, true
+	}
+
+	value, found, err := lookup(id.URN, id.Account)
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing resource", "Could not resolve URN "+id.URN+", unexpected error: "+err.Error())
+		return id, false
+	}
+	if !found {
+		resp.Diagnostics.AddError("Error importing resource", fmt.Sprintf("No entity with URN %q was found.", id.URN))
+		return id, false
+	}
+	id.ID = value
+	return id, true
+}
+
+// findByURN returns value of the entity with the URN.
+func findByURN[T any](entities []T, urn string, entityURN func(T) string, value func(T) string) (string, bool) {
+	for _, e := range entities {
+		if entityURN(e) == urn {
+			return value(e), true
+		}
+	}
+	return "", false
+}
+
+// importNamePrefix marks an import ID that is the name of the entity rather
+// than its id.
+const importNamePrefix = "name:"
+
+// importCatalogueEntity imports a catalogue entity by its id, its NEOS URN or
+// name:<name>. A name must match exactly one entity.
+func importCatalogueEntity[T any](ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse, entityType string, list func() ([]T, error), fields func(T) (id string, name string, urn string)) {
+	name, byName := strings.CutPrefix(req.ID, importNamePrefix)
+	if !byName {
+		importByIDOrURN(ctx, req, resp, "id", func(urn string, _ string) (string, bool, error) {
+			entities, err := list()
+			if err != nil {
+				return "", false, err
+			}
+			id, found := findByURN(entities, urn, func(e T) string { _, _, u := fields(e); return u }, func(e T) string { id, _, _ := fields(e); return id })
+			return id, found, nil
+		})
+		return
+	}
+
+	if name == "" {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected an import ID of name:<name>, got: %q", req.ID))
+		return
+	}
+
+	entities, err := list()
+	if err != nil {
+		resp.Diagnostics.AddError("Error importing resource", "Could not list "+entityType+" entities, unexpected error: "+err.Error())
+		return
+	}
+
+	matches := filterEntities(entities, func(e T) bool { _, n, _ := fields(e); return n == name })
+	if !expectSingleEntity(&resp.Diagnostics, entityType, fmt.Sprintf("name %q", name), len(matches)) {
+		return
+	}
+
+	id, _, _ := fields(matches[0])
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+}
@@ -0,0 +1,272 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+const (
+	lineageUpstream   = "upstream"
+	lineageDownstream = "downstream"
+	lineageBoth       = "both"
+	lineageRoot       = "root"
+)
+
+// lineageNode is an entity in a lineage graph, Depth is the number of links
+// between it and the root.
+type lineageNode struct {
+	ID         string `json:"id"`
+	Name       string `json:"name"`
+	URN        string `json:"urn"`
+	EntityType string `json:"entity_type"`
+	OutputType string `json:"output_type,omitempty"`
+	Depth      int    `json:"depth"`
+	Direction  string `json:"direction"`
+}
+
+// lineageEdge is a link from a parent to a child entity.
+type lineageEdge struct {
+	ParentID string
+	ChildID  string
+}
+
+// lineageIndex holds every link by parent and by child.
+type lineageIndex struct {
+	entities map[string]lineageNode
+	children map[string][]string
+	parents  map[string][]string
+}
+
+// newLineageIndex indexes the links, the children and parents of an entity
+// are sorted by id so traversals are stable.
+func newLineageIndex(list neos.LinksGetResponse) lineageIndex {
+	idx := lineageIndex{
+		entities: map[string]lineageNode{},
+		children: map[string][]string{},
+		parents:  map[string][]string{},
+	}
+
+	for _, l := range list.Links {
+		idx.entities[l.Parent.Identifier] = lineageNode{ID: l.Parent.Identifier, Name: l.Parent.Name, URN: l.Parent.Urn, EntityType: l.Parent.EntityType, OutputType: l.Parent.OutputType}
+		idx.entities[l.Child.Identifier] = lineageNode{ID: l.Child.Identifier, Name: l.Child.Name, URN: l.Child.Urn, EntityType: l.Child.EntityType, OutputType: l.Child.OutputType}
+		idx.children[l.Parent.Identifier] = append(idx.children[l.Parent.Identifier], l.Child.Identifier)
+		idx.parents[l.Child.Identifier] = append(idx.parents[l.Child.Identifier], l.Parent.Identifier)
+	}
+
+	for _, m := range []map[string][]string{idx.children, idx.parents} {
+		for id := range m {
+			sort.Strings(m[id])
+		}
+	}
+	return idx
+}
+
+// lineageGraph is the part of the lineage reachable from the root.
+type lineageGraph struct {
+	Root  string
+	Nodes []lineageNode
+	Edges []lineageEdge
+}
+
+// lineage walks the links from the root in the direction, up to depth links
+// away, a depth of 0 is unlimited. The root is looked up in the links, root
+// holds its details when it has none.
+func (idx lineageIndex) lineage(root lineageNode, direction string, depth int) lineageGraph {
+	if e, ok := idx.entities[root.ID]; ok {
+		root = e
+	}
+	root.Depth = 0
+	root.Direction = lineageRoot
+
+	g := lineageGraph{Root: root.ID, Nodes: []lineageNode{root}}
+	position := map[string]int{root.ID: 0}
+	edges := map[lineageEdge]bool{}
+
+	walk := func(dir string, next map[string][]string) {
+		queue := []string{root.ID}
+		seen := map[string]int{root.ID: 0}
+		for len(queue) > 0 {
+			id := queue[0]
+			queue = queue[1:]
+			if depth > 0 && seen[id] >= depth {
+				continue
+			}
+			for _, n := range next[id] {
+				edge := lineageEdge{ParentID: id, ChildID: n}
+				if dir == lineageUpstream {
+					edge = lineageEdge{ParentID: n, ChildID: id}
+				}
+				edges[edge] = true
+
+				if _, ok := seen[n]; ok {
+					continue
+				}
+				seen[n] = seen[id] + 1
+				queue = append(queue, n)
+
+				if i, ok := position[n]; ok {
+					// Reached both ways, only possible when the links have a cycle.
+					if g.Nodes[i].Direction != lineageRoot && g.Nodes[i].Direction != dir {
+						g.Nodes[i].Direction = lineageBoth
+					}
+					if seen[n] < g.Nodes[i].Depth {
+						g.Nodes[i].Depth = seen[n]
+					}
+					continue
+				}
+				node := idx.entities[n]
+				node.Depth = seen[n]
+				node.Direction = dir
+				position[n] = len(g.Nodes)
+				g.Nodes = append(g.Nodes, node)
+			}
+		}
+	}
+
+	if direction != lineageDownstream {
+		walk(lineageUpstream, idx.parents)
+	}
+	if direction != lineageUpstream {
+		walk(lineageDownstream, idx.children)
+	}
+
+	for e := range edges {
+		g.Edges = append(g.Edges, e)
+	}
+	sort.Slice(g.Edges, func(i, j int) bool {
+		a, b := g.Edges[i], g.Edges[j]
+		if position[a.ParentID] != position[b.ParentID] {
+			return position[a.ParentID] < position[b.ParentID]
+		}
+		return position[a.ChildID] < position[b.ChildID]
+	})
+	return g
+}
+
+// label is the text a node is drawn with.
+func (n lineageNode) label() string {
+	name := n.Name
+	if name == "" {
+		name = n.ID
+	}
+	if n.EntityType == "" {
+		return name
+	}
+	return name + "\n" + n.EntityType
+}
+
+// dot renders the graph in the Graphviz DOT language.
+func (g lineageGraph) dot() string {
+	quote := strings.NewReplacer(`\`, `\\`, `"`, `\"`, "\n", `\n`)
+
+	b := strings.Builder{}
+	b.WriteString("digraph lineage {\n  rankdir=LR;\n")
+	for _, n := range g.Nodes {
+		attrs := fmt.Sprintf(`label="%s"`, quote.Replace(n.label()))
+		if n.Direction == lineageRoot {
+			attrs += ", style=bold"
+		}
+		fmt.Fprintf(&b, "  \"%s\" [%s];\n", quote.Replace(n.ID), attrs)
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  \"%s\" -> \"%s\";\n", quote.Replace(e.ParentID), quote.Replace(e.ChildID))
+	}
+	b.WriteString("}\n")
+	return b.String()
+}
+
+// mermaid renders the graph as a Mermaid flowchart, nodes are numbered as ids
+// may hold characters Mermaid does not allow.
+func (g lineageGraph) mermaid() string {
+	quote := strings.NewReplacer(`"`, "#quot;", "\n", "<br/>")
+
+	key := map[string]string{}
+	b := strings.Builder{}
+	b.WriteString("flowchart LR\n")
+	for i, n := range g.Nodes {
+		key[n.ID] = fmt.Sprintf("n%d", i)
+		shape := `["%s"]`
+		if n.Direction == lineageRoot {
+			shape = `(["%s"])`
+		}
+		fmt.Fprintf(&b, "  %s"+shape+"\n", key[n.ID], quote.Replace(n.label()))
+	}
+	for _, e := range g.Edges {
+		fmt.Fprintf(&b, "  %s --> %s\n", key[e.ParentID], key[e.ChildID])
+	}
+	return b.String()
+}
+
+// adjacencyJSON renders the graph as JSON, adjacency maps the id of every
+// node to the ids of its children in the graph.
+func (g lineageGraph) adjacencyJSON() (string, error) {
+	adjacency := map[string][]string{}
+	for _, n := range g.Nodes {
+		adjacency[n.ID] = []string{}
+	}
+	for _, e := range g.Edges {
+		adjacency[e.ParentID] = append(adjacency[e.ParentID], e.ChildID)
+	}
+
+	b, err := json.Marshal(struct {
+		Root      string              `json:"root"`
+		Nodes     []lineageNode       `json:"nodes"`
+		Adjacency map[string][]string `json:"adjacency"`
+	}{g.Root, g.Nodes, adjacency})
+	return string(b), err
+}
+
+// path returns the shortest chain of links from one entity to another as the
+// ids along it, found is false when to can not be reached from from.
+func (idx lineageIndex) path(from string, to string) ([]string, bool) {
+	previous := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		id := queue[0]
+		queue = queue[1:]
+		if id == to {
+			rtn := []string{}
+			for n := to; n != from; n = previous[n] {
+				rtn = append([]string{n}, rtn...)
+			}
+			return append([]string{from}, rtn...), true
+		}
+		for _, n := range idx.children[id] {
+			if _, ok := previous[n]; !ok {
+				previous[n] = id
+				queue = append(queue, n)
+			}
+		}
+	}
+	return nil, false
+}
+
+// linkCycle returns the cycle a new link from the parent to the child would
+// close as the names along it, found is false when there is none. The link
+// closes a cycle when the parent is already downstream of the child, or when
+// it links an entity to itself.
+func (idx lineageIndex) linkCycle(parentID string, childID string) (string, bool) {
+	chain, found := idx.path(childID, parentID)
+	if !found {
+		return "", false
+	}
+
+	names := []string{idx.name(parentID)}
+	for _, id := range chain {
+		names = append(names, idx.name(id))
+	}
+	return strings.Join(names, " -> "), true
+}
+
+// name is how an entity is shown in messages, its name and id when the name
+// is known.
+func (idx lineageIndex) name(id string) string {
+	if e, ok := idx.entities[id]; ok && e.Name != "" {
+		return fmt.Sprintf("%s (%s)", e.Name, id)
+	}
+	return id
+}
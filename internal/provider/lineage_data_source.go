@@ -0,0 +1,242 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	jt "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewLineageDataSource() datasource.DataSource {
+	return &lineageDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &lineageDataSource{}
+	_ datasource.DataSourceWithConfigure = &lineageDataSource{}
+)
+
+// lineageDataSource walks the links up and down from a root entity.
+type lineageDataSource struct {
+	client *neos.NeosClient
+}
+
+type lineageDataSourceModel struct {
+	RootID    types.String       `tfsdk:"root_id"`
+	Direction types.String       `tfsdk:"direction"`
+	Depth     types.Int64        `tfsdk:"depth"`
+	Nodes     []lineageNodeModel `tfsdk:"nodes"`
+	Edges     []lineageEdgeModel `tfsdk:"edges"`
+	Dot       types.String       `tfsdk:"dot"`
+	Mermaid   types.String       `tfsdk:"mermaid"`
+	Json      jt.Normalized      `tfsdk:"json"`
+}
+
+type lineageNodeModel struct {
+	ID         types.String `tfsdk:"id"`
+	Name       types.String `tfsdk:"name"`
+	Urn        types.String `tfsdk:"urn"`
+	EntityType types.String `tfsdk:"entity_type"`
+	OutputType types.String `tfsdk:"output_type"`
+	Depth      types.Int64  `tfsdk:"depth"`
+	Direction  types.String `tfsdk:"direction"`
+}
+
+type lineageEdgeModel struct {
+	ParentID types.String `tfsdk:"parent_id"`
+	ChildID  types.String `tfsdk:"child_id"`
+}
+
+func (d *lineageDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_lineage"
+}
+
+func (d *lineageDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Walks the links upstream and downstream from a root entity and renders the lineage graph",
+		Attributes: map[string]schema.Attribute{
+			"root_id": schema.StringAttribute{
+				Computed:    false,
+				Optional:    false,
+				Required:    true,
+				Description: "The identifier of the entity the lineage is walked from",
+			},
+			"direction": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The direction to walk the links, one of upstream, downstream or both. Defaults to both",
+				Validators: []validator.String{
+					stringvalidator.OneOf(lineageUpstream, lineageDownstream, lineageBoth),
+				},
+			},
+			"depth": schema.Int64Attribute{
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "The number of links to walk from the root, all of them when not set",
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"nodes": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The entities in the lineage, the root first",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							Computed: true,
+						},
+						"name": schema.StringAttribute{
+							Computed: true,
+						},
+						"urn": schema.StringAttribute{
+							Computed: true,
+						},
+						"entity_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"output_type": schema.StringAttribute{
+							Computed: true,
+						},
+						"depth": schema.Int64Attribute{
+							Computed:    true,
+							Description: "The number of links between the entity and the root",
+						},
+						"direction": schema.StringAttribute{
+							Computed:    true,
+							Description: "root, upstream, downstream, or both when the links have a cycle",
+						},
+					},
+				},
+			},
+			"edges": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The links between the entities in the lineage",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"parent_id": schema.StringAttribute{
+							Computed: true,
+						},
+						"child_id": schema.StringAttribute{
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dot": schema.StringAttribute{
+				Computed:    true,
+				Description: "The lineage in the Graphviz DOT language",
+			},
+			"mermaid": schema.StringAttribute{
+				Computed:    true,
+				Description: "The lineage as a Mermaid flowchart",
+			},
+			"json": schema.StringAttribute{
+				Computed:    true,
+				CustomType:  jt.NormalizedType{},
+				Description: "The lineage as JSON with the root, the nodes and an adjacency map from each node id to its children",
+			},
+		},
+	}
+}
+
+func (d *lineageDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	tflog.Info(ctx, "lineageDataSource READ")
+
+	var state lineageDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if state.Direction.IsNull() {
+		state.Direction = types.StringValue(lineageBoth)
+	}
+
+	list, err := d.client.LinksClient.Get()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read Links", err.Error())
+		return
+	}
+
+	idx := newLineageIndex(list)
+	root := lineageNode{ID: state.RootID.ValueString()}
+	if _, ok := idx.entities[root.ID]; !ok {
+		// An entity without links is the only node of its lineage.
+		entityType, found, err := lookupEntityType(d.client, root.ID)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to look up root entity", err.Error())
+			return
+		}
+		if !found {
+			resp.Diagnostics.AddAttributeError(path.Root("root_id"), "Entity not found", fmt.Sprintf("No data system, data source, data unit, data product or output has the id %q.", root.ID))
+			return
+		}
+		root.EntityType = entityType
+	}
+
+	g := idx.lineage(root, state.Direction.ValueString(), int(state.Depth.ValueInt64()))
+
+	state.Nodes = []lineageNodeModel{}
+	for _, n := range g.Nodes {
+		state.Nodes = append(state.Nodes, lineageNodeModel{
+			ID:         types.StringValue(n.ID),
+			Name:       types.StringValue(n.Name),
+			Urn:        types.StringValue(n.URN),
+			EntityType: types.StringValue(n.EntityType),
+			OutputType: types.StringValue(n.OutputType),
+			Depth:      types.Int64Value(int64(n.Depth)),
+			Direction:  types.StringValue(n.Direction),
+		})
+	}
+	state.Edges = []lineageEdgeModel{}
+	for _, e := range g.Edges {
+		state.Edges = append(state.Edges, lineageEdgeModel{
+			ParentID: types.StringValue(e.ParentID),
+			ChildID:  types.StringValue(e.ChildID),
+		})
+	}
+
+	adjacency, err := g.adjacencyJSON()
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to render lineage", err.Error())
+		return
+	}
+	state.Dot = types.StringValue(g.dot())
+	state.Mermaid = types.StringValue(g.mermaid())
+	state.Json = jt.NewNormalizedValue(adjacency)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *lineageDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "lineageDataSource configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected lineageDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = &client.NeosClient
+}
@@ -0,0 +1,298 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+// newTestLineageIndex indexes links given as parent and child ids, every
+// entity is named after its id in upper case.
+func newTestLineageIndex(t *testing.T, links ...[2]string) lineageIndex {
+	t.Helper()
+
+	entity := func(id string) map[string]string {
+		return map[string]string{"identifier": id, "name": strings.ToUpper(id), "entity_type": "data_product"}
+	}
+	body := []map[string]any{}
+	for _, l := range links {
+		body = append(body, map[string]any{"parent": entity(l[0]), "child": entity(l[1])})
+	}
+
+	b, err := json.Marshal(map[string]any{"links": body})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var list neos.LinksGetResponse
+	if err := json.Unmarshal(b, &list); err != nil {
+		t.Fatal(err)
+	}
+	return newLineageIndex(list)
+}
+
+func TestLineageIndexPath(t *testing.T) {
+	idx := newTestLineageIndex(t,
+		[2]string{"a", "b"},
+		[2]string{"b", "c"},
+		[2]string{"c", "d"},
+		[2]string{"a", "d"},
+		[2]string{"x", "x"},
+	)
+
+	tests := []struct {
+		name      string
+		from      string
+		to        string
+		want      []string
+		wantFound bool
+	}{
+		{name: "direct child", from: "a", to: "b", want: []string{"a", "b"}, wantFound: true},
+		{name: "shortest of two chains", from: "a", to: "d", want: []string{"a", "d"}, wantFound: true},
+		{name: "longer chain", from: "b", to: "d", want: []string{"b", "c", "d"}, wantFound: true},
+		{name: "links are followed from parent to child only", from: "d", to: "a", wantFound: false},
+		{name: "unrelated entities", from: "a", to: "x", wantFound: false},
+		{name: "unknown entity", from: "a", to: "missing", wantFound: false},
+		{name: "same entity", from: "c", to: "c", want: []string{"c"}, wantFound: true},
+		{name: "self linked entity", from: "x", to: "x", want: []string{"x"}, wantFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := idx.path(tt.from, tt.to)
+			if found != tt.wantFound {
+				t.Fatalf("path(%q, %q) found = %v, want %v", tt.from, tt.to, found, tt.wantFound)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("path(%q, %q) = %v, want %v", tt.from, tt.to, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineageIndexLinkCycle(t *testing.T) {
+	idx := newTestLineageIndex(t,
+		[2]string{"a", "b"},
+		[2]string{"b", "c"},
+		[2]string{"x", "x"},
+	)
+	name := func(id string) string { return fmt.Sprintf("%s (%s)", strings.ToUpper(id), id) }
+
+	tests := []struct {
+		name      string
+		parent    string
+		child     string
+		want      string
+		wantFound bool
+	}{
+		{name: "extends the chain", parent: "c", child: "d", wantFound: false},
+		{name: "shortcut in the same direction", parent: "a", child: "c", wantFound: false},
+		{name: "back to the direct parent", parent: "b", child: "a", want: name("b") + " -> " + name("a") + " -> " + name("b"), wantFound: true},
+		{name: "back to the start of the chain", parent: "c", child: "a", want: name("c") + " -> " + name("a") + " -> " + name("b") + " -> " + name("c"), wantFound: true},
+		{name: "self link", parent: "a", child: "a", want: name("a") + " -> " + name("a"), wantFound: true},
+		{name: "self link of an unlinked entity", parent: "new", child: "new", want: "new -> new", wantFound: true},
+		{name: "existing self link", parent: "x", child: "x", want: name("x") + " -> " + name("x"), wantFound: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, found := idx.linkCycle(tt.parent, tt.child)
+			if found != tt.wantFound {
+				t.Fatalf("linkCycle(%q, %q) found = %v, want %v", tt.parent, tt.child, found, tt.wantFound)
+			}
+			if got != tt.want {
+				t.Errorf("linkCycle(%q, %q) = %q, want %q", tt.parent, tt.child, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineageIndexLineage(t *testing.T) {
+	idx := newTestLineageIndex(t,
+		[2]string{"a", "b"},
+		[2]string{"e", "b"},
+		[2]string{"b", "c"},
+		[2]string{"c", "d"},
+		[2]string{"x", "y"},
+		[2]string{"y", "z"},
+		[2]string{"z", "x"},
+	)
+
+	tests := []struct {
+		name      string
+		root      lineageNode
+		direction string
+		depth     int
+		wantNodes []string
+		wantEdges []string
+	}{
+		{
+			name:      "downstream",
+			root:      lineageNode{ID: "b"},
+			direction: lineageDownstream,
+			wantNodes: []string{"b root 0", "c downstream 1", "d downstream 2"},
+			wantEdges: []string{"b->c", "c->d"},
+		},
+		{
+			name:      "downstream limited to one link",
+			root:      lineageNode{ID: "b"},
+			direction: lineageDownstream,
+			depth:     1,
+			wantNodes: []string{"b root 0", "c downstream 1"},
+			wantEdges: []string{"b->c"},
+		},
+		{
+			name:      "upstream",
+			root:      lineageNode{ID: "b"},
+			direction: lineageUpstream,
+			wantNodes: []string{"b root 0", "a upstream 1", "e upstream 1"},
+			wantEdges: []string{"a->b", "e->b"},
+		},
+		{
+			name:      "upstream from a leaf limited to two links",
+			root:      lineageNode{ID: "d"},
+			direction: lineageUpstream,
+			depth:     2,
+			wantNodes: []string{"d root 0", "c upstream 1", "b upstream 2"},
+			wantEdges: []string{"c->d", "b->c"},
+		},
+		{
+			name:      "both directions",
+			root:      lineageNode{ID: "b"},
+			direction: lineageBoth,
+			wantNodes: []string{"b root 0", "a upstream 1", "e upstream 1", "c downstream 1", "d downstream 2"},
+			wantEdges: []string{"b->c", "a->b", "e->b", "c->d"},
+		},
+		{
+			name:      "both directions on a cycle",
+			root:      lineageNode{ID: "x"},
+			direction: lineageBoth,
+			wantNodes: []string{"x root 0", "z both 1", "y both 1"},
+			wantEdges: []string{"x->y", "z->x", "y->z"},
+		},
+		{
+			name:      "downstream on a cycle stops at the root",
+			root:      lineageNode{ID: "x"},
+			direction: lineageDownstream,
+			wantNodes: []string{"x root 0", "y downstream 1", "z downstream 2"},
+			wantEdges: []string{"x->y", "y->z", "z->x"},
+		},
+		{
+			name:      "root without links",
+			root:      lineageNode{ID: "lonely", Name: "Lonely", EntityType: "output"},
+			direction: lineageBoth,
+			wantNodes: []string{"lonely root 0"},
+			wantEdges: []string{},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			g := idx.lineage(tt.root, tt.direction, tt.depth)
+			if g.Root != tt.root.ID {
+				t.Errorf("Root = %q, want %q", g.Root, tt.root.ID)
+			}
+
+			nodes := []string{}
+			for _, n := range g.Nodes {
+				nodes = append(nodes, fmt.Sprintf("%s %s %d", n.ID, n.Direction, n.Depth))
+			}
+			if !reflect.DeepEqual(nodes, tt.wantNodes) {
+				t.Errorf("nodes = %q, want %q", nodes, tt.wantNodes)
+			}
+
+			edges := []string{}
+			for _, e := range g.Edges {
+				edges = append(edges, e.ParentID+"->"+e.ChildID)
+			}
+			if !reflect.DeepEqual(edges, tt.wantEdges) {
+				t.Errorf("edges = %q, want %q", edges, tt.wantEdges)
+			}
+		})
+	}
+
+	lonely := idx.lineage(lineageNode{ID: "lonely", Name: "Lonely", EntityType: "output"}, lineageBoth, 0)
+	if n := lonely.Nodes[0]; n.Name != "Lonely" || n.EntityType != "output" {
+		t.Errorf("root without links = %+v, want the details it was given", n)
+	}
+}
+
+func TestLineageGraphRender(t *testing.T) {
+	g := lineageGraph{
+		Root: "r",
+		Nodes: []lineageNode{
+			{ID: "r", Name: "Say \"hi\"\nnow", EntityType: "data_product", Direction: lineageRoot},
+			{ID: `c"1`, Name: "", EntityType: "", Direction: lineageDownstream, Depth: 1},
+			{ID: "back\\slash", Name: "Back\\slash", EntityType: "output", Direction: lineageDownstream, Depth: 1},
+		},
+		Edges: []lineageEdge{{ParentID: "r", ChildID: `c"1`}, {ParentID: "r", ChildID: "back\\slash"}},
+	}
+
+	tests := []struct {
+		name   string
+		render func() string
+		want   string
+	}{
+		{
+			name:   "dot",
+			render: g.dot,
+			want: "digraph lineage {\n  rankdir=LR;\n" +
+				"  \"r\" [label=\"Say \\\"hi\\\"\\nnow\\ndata_product\", style=bold];\n" +
+				"  \"c\\\"1\" [label=\"c\\\"1\"];\n" +
+				"  \"back\\\\slash\" [label=\"Back\\\\slash\\noutput\"];\n" +
+				"  \"r\" -> \"c\\\"1\";\n" +
+				"  \"r\" -> \"back\\\\slash\";\n" +
+				"}\n",
+		},
+		{
+			name:   "mermaid",
+			render: g.mermaid,
+			want: "flowchart LR\n" +
+				"  n0([\"Say #quot;hi#quot;<br/>now<br/>data_product\"])\n" +
+				"  n1[\"c#quot;1\"]\n" +
+				"  n2[\"Back\\slash<br/>output\"]\n" +
+				"  n0 --> n1\n" +
+				"  n0 --> n2\n",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.render(); got != tt.want {
+				t.Errorf("got\n%s\nwant\n%s", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLineageGraphAdjacencyJSON(t *testing.T) {
+	idx := newTestLineageIndex(t,
+		[2]string{"a", "b"},
+		[2]string{"b", "c"},
+		[2]string{"b", "d"},
+	)
+	j, err := idx.lineage(lineageNode{ID: "b"}, lineageBoth, 0).adjacencyJSON()
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	var got struct {
+		Root      string              `json:"root"`
+		Nodes     []lineageNode       `json:"nodes"`
+		Adjacency map[string][]string `json:"adjacency"`
+	}
+	if err := json.Unmarshal([]byte(j), &got); err != nil {
+		t.Fatal(err)
+	}
+
+	wantAdjacency := map[string][]string{"a": {"b"}, "b": {"c", "d"}, "c": {}, "d": {}}
+	if got.Root != "b" || len(got.Nodes) != 4 || !reflect.DeepEqual(got.Adjacency, wantAdjacency) {
+		t.Errorf("adjacencyJSON() = %s, want root b, 4 nodes and adjacency %v", j, wantAdjacency)
+	}
+	if got.Nodes[1].ID != "a" || got.Nodes[1].Name != "A" || got.Nodes[1].Direction != lineageUpstream || got.Nodes[1].Depth != 1 {
+		t.Errorf("nodes[1] = %+v, want a upstream at depth 1", got.Nodes[1])
+	}
+}
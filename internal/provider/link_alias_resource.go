@@ -0,0 +1,282 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewLinkDataSystemDataSourceResource() resource.Resource {
+	return &linkAliasResource{parentType: "data_system", childType: "data_source"}
+}
+
+func NewLinkDataSourceDataUnitResource() resource.Resource {
+	return &linkAliasResource{parentType: "data_source", childType: "data_unit"}
+}
+
+func NewLinkDataUnitDataProductResource() resource.Resource {
+	return &linkAliasResource{parentType: "data_unit", childType: "data_product"}
+}
+
+func NewLinkDataProductDataProductResource() resource.Resource {
+	return &linkAliasResource{parentType: "data_product", childType: "data_product"}
+}
+
+func NewLinkDataProductOutputResource() resource.Resource {
+	return &linkAliasResource{parentType: "data_product", childType: "output"}
+}
+
+// linkAliasResource is a neos_link_<parent>_<child> resource, a neos_link with
+// fixed parent and child types and the attribute names it had before
+// neos_link.
+type linkAliasResource struct {
+	client     *neos.NeosClient
+	parentType string
+	childType  string
+}
+
+var (
+	_ resource.Resource                = &linkAliasResource{}
+	_ resource.ResourceWithConfigure   = &linkAliasResource{}
+	_ resource.ResourceWithImportState = &linkAliasResource{}
+	_ resource.ResourceWithModifyPlan  = &linkAliasResource{}
+)
+
+// Metadata returns the resource type name.
+func (r *linkAliasResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link_" + r.parentType + "_" + r.childType
+}
+
+// Schema defines the schema for the resource.
+func (r *linkAliasResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The compound identifier of the link",
+			},
+			"parent_identifier": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: fmt.Sprintf("The %s parent identifier", entityTypeDescription(r.parentType)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"child_identifier": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: fmt.Sprintf("The %s child identifier", entityTypeDescription(r.childType)),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "Last updated time",
+			},
+		},
+	}
+}
+
+// entityTypeDescription is the entity type as it reads in a description, for
+// example data system for data_system.
+func entityTypeDescription(entityType string) string {
+	if entityType == "output" {
+		return entityType
+	}
+	return "data " + entityType[len("data_"):]
+}
+
+// linkAliasResourceModel maps the resource schema data.
+type linkAliasResourceModel struct {
+	ID               types.String `tfsdk:"id"`
+	ParentIdentifier types.String `tfsdk:"parent_identifier"`
+	ChildIdentifier  types.String `tfsdk:"child_identifier"`
+	LastUpdated      types.String `tfsdk:"last_updated"`
+}
+
+// ModifyPlan checks the parent and child are of the types the resource links
+// when their ids are known.
+func (r *linkAliasResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan linkAliasResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !req.State.Raw.IsNull() {
+		var state linkAliasResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.ParentIdentifier.Equal(state.ParentIdentifier) && plan.ChildIdentifier.Equal(state.ChildIdentifier)) {
+			return
+		}
+	}
+
+	if !plan.ParentIdentifier.IsUnknown() {
+		resolveLinkEntityType(r.client, &resp.Diagnostics, "parent_identifier", plan.ParentIdentifier.ValueString(), types.StringValue(r.parentType))
+	}
+	if !plan.ChildIdentifier.IsUnknown() {
+		resolveLinkEntityType(r.client, &resp.Diagnostics, "child_identifier", plan.ChildIdentifier.ValueString(), types.StringValue(r.childType))
+	}
+
+	if resp.Diagnostics.HasError() || plan.ParentIdentifier.IsUnknown() || plan.ChildIdentifier.IsUnknown() {
+		return
+	}
+	if r.linksProducts() {
+		validateLinkCycle(&r.client.LinksClient, &resp.Diagnostics, plan.ParentIdentifier.ValueString(), plan.ChildIdentifier.ValueString())
+	}
+}
+
+// linksProducts reports if the resource links a data product to a data
+// product, the only links that can make a cycle.
+func (r *linkAliasResource) linksProducts() bool {
+	return r.parentType == "data_product" && r.childType == "data_product"
+}
+
+// Create a new resource.
+func (r *linkAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan linkAliasResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The ids may only have been known after the plan was made.
+	if r.linksProducts() && !validateLinkCycle(&r.client.LinksClient, &resp.Diagnostics, plan.ParentIdentifier.ValueString(), plan.ChildIdentifier.ValueString()) {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("linkAliasResource Create %s [%s] -> %s [%s]", r.parentType, plan.ParentIdentifier.ValueString(), r.childType, plan.ChildIdentifier.ValueString()))
+
+	result, err := r.client.LinksClient.Post(ctx, r.parentType, r.childType, plan.ParentIdentifier.ValueString(), plan.ChildIdentifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating link",
+			"Could not create link, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(result.Parent.Identifier + "-" + result.Child.Identifier)
+	plan.ParentIdentifier = types.StringValue(result.Parent.Identifier)
+	plan.ChildIdentifier = types.StringValue(result.Child.Identifier)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *linkAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state linkAliasResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, _, found, err := findLink(&r.client.LinksClient, state.ParentIdentifier.ValueString(), state.ChildIdentifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading NEOS links",
+			"Could not read Links "+state.ParentIdentifier.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("linkAliasResource link %s not found, removing from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.ParentIdentifier.ValueString() + "-" + state.ChildIdentifier.ValueString())
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called as every argument replaces the link, it keeps the
+// state in line with the plan.
+func (r *linkAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan linkAliasResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *linkAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state linkAliasResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.LinksClient.Delete(ctx, r.parentType, r.childType, state.ParentIdentifier.ValueString(), state.ChildIdentifier.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting link", "Could not delete link, unexpected error: "+err.Error())
+		return
+	}
+}
+
+func (r *linkAliasResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = &client.NeosClient
+}
+
+// ImportState imports a link from <parent_id>/<child_id>.
+func (r *linkAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parentID, childID, ok := parseLinkImportID(&resp.Diagnostics, req.ID)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_identifier"), parentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("child_identifier"), childID)...)
+}
@@ -0,0 +1,481 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+	"github.com/owain-nortal/terraform-provider-neos/internal/neosapi"
+)
+
+// linkEntityTypes are the entity types that can be linked.
+var linkEntityTypes = []string{"data_system", "data_source", "data_unit", "data_product", "output"}
+
+// validateLinkPair adds an error to diags when the parent and child types can
+// not be linked.
+func validateLinkPair(diags *diag.Diagnostics, parentType string, childType string) bool {
+	if neosapi.LinkPairs[[2]string{parentType, childType}] {
+		return true
+	}
+
+	pairs := []string{}
+	for p := range neosapi.LinkPairs {
+		pairs = append(pairs, p[0]+" -> "+p[1])
+	}
+	sort.Strings(pairs)
+	diags.AddError(
+		"Invalid link",
+		fmt.Sprintf("A %s can not be linked to a %s, the links NEOS supports are: %s.", parentType, childType, strings.Join(pairs, ", ")),
+	)
+	return false
+}
+
+// lookupEntityType returns the type of the catalogue entity with the id,
+// found is false when no entity has it.
+func lookupEntityType(client *neos.NeosClient, id string) (entityType string, found bool, err error) {
+	systems, err := client.DataSystemClient.Get()
+	if err != nil {
+		return "", false, err
+	}
+	for _, e := range systems.Entities {
+		if e.Identifier == id {
+			return "data_system", true, nil
+		}
+	}
+
+	sources, err := client.DataSourceClient.Get()
+	if err != nil {
+		return "", false, err
+	}
+	for _, e := range sources.Entities {
+		if e.Identifier == id {
+			return "data_source", true, nil
+		}
+	}
+
+	units, err := client.DataUnitClient.Get()
+	if err != nil {
+		return "", false, err
+	}
+	for _, e := range units.Entities {
+		if e.Identifier == id {
+			return "data_unit", true, nil
+		}
+	}
+
+	products, err := client.DataProductClient.Get()
+	if err != nil {
+		return "", false, err
+	}
+	for _, e := range products.Entities {
+		if e.Identifier == id {
+			return "data_product", true, nil
+		}
+	}
+
+	outputs, err := client.OutputClient.Get()
+	if err != nil {
+		return "", false, err
+	}
+	for _, e := range outputs.Entities {
+		if e.Identifier == id {
+			return "output", true, nil
+		}
+	}
+
+	return "", false, nil
+}
+
+// resolveLinkEntityType looks up the type of the entity at attr, an error is
+// added when it does not exist or is not the expected type.
+func resolveLinkEntityType(client *neos.NeosClient, diags *diag.Diagnostics, attr string, id string, expected types.String) (string, bool) {
+	entityType, found, err := lookupEntityType(client, id)
+	if err != nil {
+		diags.AddAttributeError(path.Root(attr), "Error looking up entity", "Could not look up the type of entity "+id+", unexpected error: "+err.Error())
+		return "", false
+	}
+	if !found {
+		diags.AddAttributeError(path.Root(attr), "Entity not found", fmt.Sprintf("No data system, data source, data unit, data product or output has the id %q.", id))
+		return "", false
+	}
+	if !expected.IsNull() && !expected.IsUnknown() && expected.ValueString() != entityType {
+		diags.AddAttributeError(path.Root(attr), "Unexpected entity type", fmt.Sprintf("Entity %s is a %s, expected a %s.", id, entityType, expected.ValueString()))
+		return "", false
+	}
+	return entityType, true
+}
+
+// findLink returns the link between the parent and child, found is false when
+// they are not linked.
+func findLink(client *neos.LinksClient, parentID string, childID string) (parentType string, childType string, found bool, err error) {
+	list, err := client.Get()
+	if err != nil {
+		return "", "", false, err
+	}
+	for _, l := range list.Links {
+		if l.Parent.Identifier == parentID && l.Child.Identifier == childID {
+			return l.Parent.EntityType, l.Child.EntityType, true, nil
+		}
+	}
+	return "", "", false, nil
+}
+
+// validateLinkCycle adds an error to diags when linking the parent data
+// product to the child would make a cycle in the existing links.
+func validateLinkCycle(client *neos.LinksClient, diags *diag.Diagnostics, parentID string, childID string) bool {
+	list, err := client.Get()
+	if err != nil {
+		diags.AddError("Error Reading NEOS links", "Could not read the links to check for a cycle, unexpected error: "+err.Error())
+		return false
+	}
+
+	idx := newLineageIndex(list)
+	cycle, found := idx.linkCycle(parentID, childID)
+	if !found {
+		return true
+	}
+
+	diags.AddError(
+		"Link creates a cycle",
+		fmt.Sprintf("Linking data product %s to %s creates the cycle %s.", idx.name(parentID), idx.name(childID), cycle),
+	)
+	return false
+}
+
+// parseLinkImportID splits a <parent_id>/<child_id> link import ID.
+func parseLinkImportID(diags *diag.Diagnostics, raw string) (string, string, bool) {
+	parentID, childID, found := strings.Cut(raw, "/")
+	if !found || parentID == "" || childID == "" {
+		diags.AddError("Invalid import ID", fmt.Sprintf("Expected an import ID of <parent_id>/<child_id>, got: %q", raw))
+		return "", "", false
+	}
+	return parentID, childID, true
+}
+
+func NewLinkResource() resource.Resource {
+	return &linkResource{}
+}
+
+// linkResource links any two catalogue entities NEOS can link.
+type linkResource struct {
+	client *neos.NeosClient
+}
+
+var (
+	_ resource.Resource                   = &linkResource{}
+	_ resource.ResourceWithConfigure      = &linkResource{}
+	_ resource.ResourceWithImportState    = &linkResource{}
+	_ resource.ResourceWithModifyPlan     = &linkResource{}
+	_ resource.ResourceWithValidateConfig = &linkResource{}
+)
+
+// Metadata returns the resource type name.
+func (r *linkResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_link"
+}
+
+// Schema defines the schema for the resource.
+func (r *linkResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The identifier of the link, <parent_id>/<child_id>",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"parent_id": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The identifier of the parent entity",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"child_id": schema.StringAttribute{
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The identifier of the child entity",
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"parent_type": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    true,
+				Description: "The expected type of the parent entity, one of " + strings.Join(linkEntityTypes, ", ") + ". The type is looked up when not set",
+				Validators: []validator.String{
+					stringvalidator.OneOf(linkEntityTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"child_type": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    true,
+				Description: "The expected type of the child entity, one of " + strings.Join(linkEntityTypes, ", ") + ". The type is looked up when not set",
+				Validators: []validator.String{
+					stringvalidator.OneOf(linkEntityTypes...),
+				},
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"last_updated": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "Last updated time",
+			},
+		},
+	}
+}
+
+// linkResourceModel maps the resource schema data.
+type linkResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	ParentID    types.String `tfsdk:"parent_id"`
+	ChildID     types.String `tfsdk:"child_id"`
+	ParentType  types.String `tfsdk:"parent_type"`
+	ChildType   types.String `tfsdk:"child_type"`
+	LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+// ValidateConfig rejects expected types that can not be linked.
+func (r *linkResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config linkResourceModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if config.ParentType.IsNull() || config.ParentType.IsUnknown() || config.ChildType.IsNull() || config.ChildType.IsUnknown() {
+		return
+	}
+	validateLinkPair(&resp.Diagnostics, config.ParentType.ValueString(), config.ChildType.ValueString())
+}
+
+// ModifyPlan looks up the types of the parent and child when their ids are
+// known, so a link NEOS does not support fails at plan time.
+func (r *linkResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || r.client == nil {
+		return
+	}
+
+	var plan linkResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The types in state were looked up when the link was created.
+	if !req.State.Raw.IsNull() {
+		var state linkResourceModel
+		resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+		if resp.Diagnostics.HasError() || (plan.ParentID.Equal(state.ParentID) && plan.ChildID.Equal(state.ChildID)) {
+			return
+		}
+	}
+
+	if !plan.ParentID.IsUnknown() {
+		parentType, ok := resolveLinkEntityType(r.client, &resp.Diagnostics, "parent_id", plan.ParentID.ValueString(), plan.ParentType)
+		if !ok {
+			return
+		}
+		plan.ParentType = types.StringValue(parentType)
+	}
+
+	if !plan.ChildID.IsUnknown() {
+		childType, ok := resolveLinkEntityType(r.client, &resp.Diagnostics, "child_id", plan.ChildID.ValueString(), plan.ChildType)
+		if !ok {
+			return
+		}
+		plan.ChildType = types.StringValue(childType)
+	}
+
+	if !plan.ParentType.IsUnknown() && !plan.ParentType.IsNull() && !plan.ChildType.IsUnknown() && !plan.ChildType.IsNull() {
+		if !validateLinkPair(&resp.Diagnostics, plan.ParentType.ValueString(), plan.ChildType.ValueString()) {
+			return
+		}
+		if plan.ParentType.ValueString() == "data_product" && plan.ChildType.ValueString() == "data_product" && !plan.ParentID.IsUnknown() && !plan.ChildID.IsUnknown() {
+			if !validateLinkCycle(&r.client.LinksClient, &resp.Diagnostics, plan.ParentID.ValueString(), plan.ChildID.ValueString()) {
+				return
+			}
+		}
+	}
+
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("parent_type"), plan.ParentType)...)
+	resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("child_type"), plan.ChildType)...)
+}
+
+// Create a new resource.
+func (r *linkResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	tflog.Info(ctx, "linkResource Create")
+
+	var plan linkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentID := plan.ParentID.ValueString()
+	childID := plan.ChildID.ValueString()
+
+	// The types are unknown at plan time when the entities are created in the
+	// same apply.
+	parentType, ok := resolveLinkEntityType(r.client, &resp.Diagnostics, "parent_id", parentID, plan.ParentType)
+	if !ok {
+		return
+	}
+	childType, ok := resolveLinkEntityType(r.client, &resp.Diagnostics, "child_id", childID, plan.ChildType)
+	if !ok {
+		return
+	}
+	if !validateLinkPair(&resp.Diagnostics, parentType, childType) {
+		return
+	}
+	if parentType == "data_product" && childType == "data_product" && !validateLinkCycle(&r.client.LinksClient, &resp.Diagnostics, parentID, childID) {
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("linkResource Create %s [%s] -> %s [%s]", parentType, parentID, childType, childID))
+
+	_, err := r.client.LinksClient.Post(ctx, parentType, childType, parentID, childID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error creating link",
+			"Could not create link, unexpected error: "+err.Error(),
+		)
+		return
+	}
+
+	plan.ID = types.StringValue(parentID + "/" + childID)
+	plan.ParentType = types.StringValue(parentType)
+	plan.ChildType = types.StringValue(childType)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read resource information.
+func (r *linkResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state linkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	parentType, childType, found, err := findLink(&r.client.LinksClient, state.ParentID.ValueString(), state.ChildID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Error Reading NEOS links",
+			"Could not read link "+state.ID.ValueString()+": "+err.Error(),
+		)
+		return
+	}
+	if !found {
+		tflog.Info(ctx, fmt.Sprintf("linkResource link %s not found, removing from state", state.ID.ValueString()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	state.ID = types.StringValue(state.ParentID.ValueString() + "/" + state.ChildID.ValueString())
+	state.ParentType = types.StringValue(parentType)
+	state.ChildType = types.StringValue(childType)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update is never called as every argument replaces the link, it keeps the
+// state in line with the plan.
+func (r *linkResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan linkResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *linkResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state linkResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := r.client.LinksClient.Delete(ctx, state.ParentType.ValueString(), state.ChildType.ValueString(), state.ParentID.ValueString(), state.ChildID.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Error deleting link", "Could not delete link, unexpected error: "+err.Error())
+		return
+	}
+}
+
+func (r *linkResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = &client.NeosClient
+}
+
+// ImportState imports a link from <parent_id>/<child_id>, the types are read
+// from the link.
+func (r *linkResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	parentID, childID, ok := parseLinkImportID(&resp.Diagnostics, req.ID)
+	if !ok {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("parent_id"), parentID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("child_id"), childID)...)
+}
@@ -106,9 +106,9 @@ func (d *linksDataSourceV2) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected linksDataSourceV2 Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected linksDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 
 		return
 	}
@@ -124,7 +124,8 @@ func (d *linksDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest
 				NestedObject: schema.NestedAttributeObject{
 					Attributes: map[string]schema.Attribute{
 						"tmp": schema.StringAttribute{
-							Computed: true,
+							Computed:           true,
+							DeprecationMessage: "tmp is always abc123 and will be removed, use the neos_lineage data source to walk the links",
 						},
 						"parent": schema.SingleNestedAttribute{
 							Computed: true,
@@ -0,0 +1,153 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+	"github.com/owain-nortal/terraform-provider-neos/internal/neosapi"
+)
+
+// neosAPIClient calls the NEOS endpoints that are not yet covered by
+// neos-client-go, it shares the access token and headers used by the clients
+// in that package.
+type neosAPIClient struct {
+	hubUri  string
+	coreUri string
+	http    *neos.NeosHttp
+}
+
+func newNeosAPIClient(hubHost string, coreHost string, scheme string, account string, partition string) (*neosAPIClient, error) {
+	hubUri, err := neosapi.ResolveURI(hubHost, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	coreUri, err := neosapi.ResolveURI(coreHost, scheme)
+	if err != nil {
+		return nil, err
+	}
+
+	return &neosAPIClient{
+		hubUri:  hubUri,
+		coreUri: coreUri,
+		http:    neos.NewNeosHttp(account, partition),
+	}, nil
+}
+
+func (c *neosAPIClient) accountIsNotRootOrEmpty(account string) bool {
+	return account != "" && account != "root"
+}
+
+func (c *neosAPIClient) setAccount(account string) {
+	if c.accountIsNotRootOrEmpty(account) {
+		c.http.AddHeader("x-account-override", account)
+	}
+	c.http.AddHeader("x-account", account)
+}
+
+// userPutRequest updates the details of an existing user, the username can not
+// be changed.
+type userPutRequest struct {
+	FirstName string `json:"first_name"`
+	LastName  string `json:"last_name"`
+	Email     string `json:"email"`
+	Enabled   bool   `json:"enabled"`
+}
+
+type userPasswordPutRequest struct {
+	Password string `json:"password"`
+}
+
+func (c *neosAPIClient) UserPut(ctx context.Context, id string, user userPutRequest, account string) (neos.User, error) {
+	tflog.Info(ctx, fmt.Sprintf("UserPut %s", id))
+	var rtn neos.User
+	c.setAccount(account)
+	requestURL := fmt.Sprintf("%s/api/hub/iam/user/%s", c.hubUri, id)
+	err := c.http.PutUnmarshal(requestURL, user, http.StatusOK, &rtn)
+	return rtn, err
+}
+
+func (c *neosAPIClient) UserPasswordPut(ctx context.Context, id string, password string, account string) error {
+	tflog.Info(ctx, fmt.Sprintf("UserPasswordPut %s", id))
+	c.setAccount(account)
+	b, err := json.Marshal(userPasswordPutRequest{Password: password})
+	if err != nil {
+		return err
+	}
+	requestURL := fmt.Sprintf("%s/api/hub/iam/user/%s/password", c.hubUri, id)
+	_, err = c.http.Put(requestURL, string(b), http.StatusOK)
+	return err
+}
+
+func (c *neosAPIClient) UserInvitePost(ctx context.Context, id string, account string) error {
+	tflog.Info(ctx, fmt.Sprintf("UserInvitePost %s", id))
+	c.setAccount(account)
+	requestURL := fmt.Sprintf("%s/api/hub/iam/user/%s/invite", c.hubUri, id)
+	_, err := c.http.Post(requestURL, "{}", http.StatusOK)
+	return err
+}
+
+// isNotFoundError reports if err is the error the neos client returns for a
+// 404 response.
+func isNotFoundError(err error) bool {
+	return err != nil && strings.Contains(err.Error(), "response code 404")
+}
+
+// registryCorePutRequest renames a core in the registry, the partition of a
+// core can not be changed.
+type registryCorePutRequest struct {
+	Name string `json:"name"`
+}
+
+func (c *neosAPIClient) RegistryCorePut(ctx context.Context, id string, core registryCorePutRequest, account string) error {
+	tflog.Info(ctx, fmt.Sprintf("RegistryCorePut %s", id))
+	c.setAccount(account)
+	b, err := json.Marshal(core)
+	if err != nil {
+		return err
+	}
+	requestURL := fmt.Sprintf("%s/api/hub/registry/core/%s", c.hubUri, id)
+	_, err = c.http.Put(requestURL, string(b), http.StatusOK)
+	return err
+}
+
+// RegistryCoreKeyPairPost issues a new key pair for a core, the previous key
+// pair stops working.
+func (c *neosAPIClient) RegistryCoreKeyPairPost(ctx context.Context, id string, account string) (neos.RegistryCoreKeyPairPostResponse, error) {
+	tflog.Info(ctx, fmt.Sprintf("RegistryCoreKeyPairPost %s", id))
+	var rtn neos.RegistryCoreKeyPairPostResponse
+	c.setAccount(account)
+	requestURL := fmt.Sprintf("%s/api/hub/registry/core/%s/keypair", c.hubUri, id)
+	err := c.http.PostUnmarshal(requestURL, struct{}{}, http.StatusOK, &rtn)
+	return rtn, err
+}
+
+func (c *neosAPIClient) EntityInfoGet(entityType string, id string) (neosapi.EntityInfo, error) {
+	return neosapi.EntityInfoGet(c.http, c.coreUri, entityType, id)
+}
+
+// EntityList lists the data systems, data sources, data units, data products
+// or outputs of entityType as seen from account into output. The override is
+// sent for root too, x-account always holds the provider account.
+func (c *neosAPIClient) EntityList(entityType string, account string, output any) error {
+	c.setAccount(account)
+	if account != "" {
+		c.http.AddHeader("x-account-override", account)
+	}
+	requestURL := fmt.Sprintf("%s/api/gateway/v2/%s", c.coreUri, entityType)
+	return c.http.GetUnmarshal(requestURL, http.StatusOK, output)
+}
+
+// DataProductSchemaGet reads the schema of a data product, the response has the
+// fields but not the product type the schema was put with.
+func (c *neosAPIClient) DataProductSchemaGet(id string) (neos.DataProductSchema, error) {
+	var rtn neos.DataProductSchema
+	requestURL := fmt.Sprintf("%s/api/gateway/v2/data_product/%s/schema", c.coreUri, id)
+	err := c.http.GetUnmarshal(requestURL, http.StatusOK, &rtn)
+	return rtn, err
+}
@@ -6,7 +6,6 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
-	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	neos "github.com/owain-nortal/neos-client-go"
 )
@@ -16,8 +15,9 @@ func NewOutputDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &outputDataSourceV2{}
-	_ datasource.DataSourceWithConfigure = &outputDataSourceV2{}
+	_ datasource.DataSource                     = &outputDataSourceV2{}
+	_ datasource.DataSourceWithConfigure        = &outputDataSourceV2{}
+	_ datasource.DataSourceWithConfigValidators = &outputDataSourceV2{}
 )
 
 type outputDataSourceV2 struct {
@@ -32,38 +32,35 @@ func (d *outputDataSourceV2) Read(ctx context.Context, req datasource.ReadReques
 
 	tflog.Info(ctx, "outputDataSourceV2 READ")
 
-	var state OutputDataSourceModelV2
+	var state OutputModelV2
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
 	list, err := d.client.Get()
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Unable to Read Data System List",
+			"Unable to Read Output List",
 			err.Error(),
 		)
 		return
 	}
 
-	tflog.Info(ctx, "outputDataSourceV2 READ Post error ")
-
-	tflog.Info(ctx, fmt.Sprintf("outputDataSourceV2 READ length %d", len(list.Entities)))
-
-	// Map response body to model
-	for _, ds := range list.Entities {
-		dataSystemState := OutputModelV2{
-			Identifier:  types.StringValue(ds.Identifier),
-			Name:        types.StringValue(ds.Name),
-			Description: types.StringValue(ds.Description),
-			Label:       types.StringValue(ds.Label),
-			Owner:       types.StringValue(ds.Owner),
-			Urn:         types.StringValue(ds.Urn),
-			OutputType:  types.StringValue(ds.OutputType),
-		}
-		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Identifier))
-		state.Outputs = append(state.Outputs, dataSystemState)
+	lookup := entityLookup{ID: state.Identifier, Name: state.Name, URN: state.Urn}
+	outputs := filterEntities(list.Entities, func(o neos.Output) bool {
+		return lookup.matches(o.Identifier, o.Name, o.Urn)
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "output", lookup.String(), len(outputs)) {
+		return
 	}
 
+	state = newOutputModelV2(outputs[0])
+
 	// Set state
-	diags := resp.State.Set(ctx, &state)
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -79,9 +76,9 @@ func (d *outputDataSourceV2) Configure(ctx context.Context, req datasource.Confi
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected outputDataSourceV2Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected outputDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 
 		return
 	}
@@ -89,73 +86,15 @@ func (d *outputDataSourceV2) Configure(ctx context.Context, req datasource.Confi
 	d.client = &client.OutputClient
 }
 
-func (d *outputDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
-	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"datasystems": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"description": schema.StringAttribute{
-							Computed: true,
-						},
-						"label": schema.StringAttribute{
-							Computed: true,
-						},
-						"created_at": schema.StringAttribute{
-							Computed: true,
-						},
-						"owner": schema.StringAttribute{
-							Computed: true,
-						},
-						"output_type": schema.StringAttribute{
-							Computed: true,
-						},
-						"state": schema.SingleNestedAttribute{
-							Computed: true,
-							Attributes: map[string]schema.Attribute{
-								"state": schema.StringAttribute{
-									Computed: true,
-								},
-								"healthy": schema.BoolAttribute{
-									Computed: true,
-								},
-							},
-						},
-					},
-				},
-			},
-		},
-	}
-}
-
-type OutputDataSourceModelV2 struct {
-	Outputs []OutputModelV2 `tfsdk:"outputs"`
+func (d *outputDataSourceV2) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
 }
 
-// coffeesModel maps coffees schema data.
-type OutputModelV2 struct {
-	Identifier  types.String       `tfsdk:"id"`
-	Urn         types.String       `tfsdk:"urn"`
-	Name        types.String       `tfsdk:"name"`
-	Description types.String       `tfsdk:"description"`
-	Label       types.String       `tfsdk:"label"`
-	Owner       types.String       `tfsdk:"owner"`
-	CreatedAt   types.String       `tfsdk:"created_at"`
-	State       OutputStateModelV2 `tfsdk:"state"`
-	OutputType  types.String       `tfsdk:"output_type"`
-}
+func (d *outputDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := outputDataSourceAttributes()
+	setLookupAttributes(attributes, "output", "id", "name", "urn")
 
-type OutputStateModelV2 struct {
-	State   types.String `tfsdk:"state"`
-	Healthy types.Bool   `tfsdk:"healthy"`
+	resp.Schema = schema.Schema{
+		Attributes: attributes,
+	}
 }
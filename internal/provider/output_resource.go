@@ -6,14 +6,17 @@ import (
 
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	neos "github.com/owain-nortal/neos-client-go"
+	"golang.org/x/exp/slices"
 )
 
 // Ensure the implementation satisfies the expected interfaces.
@@ -28,7 +31,9 @@ func NewOutputResource() resource.Resource {
 
 // outputResource is the resource implementation.
 type outputResource struct {
-	client *neos.OutputClient
+	client      *neos.OutputClient
+	linksClient *neos.LinksClient
+	apiClient   *neosAPIClient
 }
 
 var (
@@ -37,6 +42,9 @@ var (
 	_ resource.ResourceWithImportState = &outputResource{}
 )
 
+// outputTypes are the output types NEOS supports.
+var outputTypes = []string{"dashboard", "application", "api", "file_export"}
+
 // Metadata returns the resource type name.
 func (r *outputResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
 	resp.TypeName = req.ProviderTypeName + "_output"
@@ -92,7 +100,17 @@ func (r *outputResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Computed:    false,
 				Optional:    true,
 				Required:    false,
-				Description: "The output type either dashboard or application",
+				Description: "The output type one of dashboard, application, api or file_export",
+				Validators: []validator.String{
+					stringvalidator.OneOf(outputTypes...),
+				},
+			},
+			"data_product_ids": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "The data products that feed this output, when set the data product to output links are managed by this resource",
 			},
 			"created_at": schema.StringAttribute{
 				Computed:    true,
@@ -117,6 +135,7 @@ func (r *outputResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 				Required:    true,
 				Description: "list of links",
 			},
+			"force_destroy": forceDestroyAttribute("output"),
 			"last_updated": schema.StringAttribute{
 				Computed: true,
 			},
@@ -126,17 +145,20 @@ func (r *outputResource) Schema(_ context.Context, _ resource.SchemaRequest, res
 
 // outputResourceModel maps the resource schema data.
 type outputResourceModel struct {
-	ID          types.String `tfsdk:"id"`
-	URN         types.String `tfsdk:"urn"`
-	Name        types.String `tfsdk:"name"`
-	Label       types.String `tfsdk:"label"`
-	Description types.String `tfsdk:"description"`
-	Owner       types.String `tfsdk:"owner"`
-	CreatedAt   types.String `tfsdk:"created_at"`
-	Links       types.List   `tfsdk:"links"`
-	ContactIds  types.List   `tfsdk:"contact_ids"`
-	LastUpdated types.String `tfsdk:"last_updated"`
-	OutputType  types.String `tfsdk:"output_type"`
+	ID           types.String `tfsdk:"id"`
+	URN          types.String `tfsdk:"urn"`
+	Name         types.String `tfsdk:"name"`
+	Label        types.String `tfsdk:"label"`
+	Description  types.String `tfsdk:"description"`
+	Owner        types.String `tfsdk:"owner"`
+	CreatedAt    types.String `tfsdk:"created_at"`
+	Links        types.List   `tfsdk:"links"`
+	ContactIds   types.List   `tfsdk:"contact_ids"`
+	ForceDestroy types.Bool   `tfsdk:"force_destroy"`
+	LastUpdated  types.String `tfsdk:"last_updated"`
+	OutputType   types.String `tfsdk:"output_type"`
+
+	DataProductIds types.Set `tfsdk:"data_product_ids"`
 }
 
 // Create a new resource.
@@ -203,6 +225,30 @@ func (r *outputResource) Create(ctx context.Context, req resource.CreateRequest,
 
 	//	tflog.Info(ctx, fmt.Sprintf("££ Create Post result [%s] [%s] [%s] [%s] [%s] [%s]", result.Identifier, result.Name, result.Urn, result.Description, result.Label, result.CreatedAt.String()))
 
+	// The id is saved first so an output whose links fail is known to
+	// Terraform, and is replaced by the next apply.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), result.Identifier)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.DataProductIds.IsNull() {
+		diags, dataProductIds := SortListValueIntoStringArray(ctx, plan.DataProductIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err = r.syncDataProductLinks(ctx, result.Identifier, dataProductIds, []string{})
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error creating output",
+				"Could not link data products to output, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	plan.ID = types.StringValue(result.Identifier)
 	plan.Name = types.StringValue(result.Name)
 	plan.URN = types.StringValue(result.Urn)
@@ -257,13 +303,39 @@ func (r *outputResource) Read(ctx context.Context, req resource.ReadRequest, res
 			state.Label = types.StringValue(ds.Label)
 			state.URN = types.StringValue(ds.Urn)
 			state.Description = types.StringValue(ds.Description)
-			state.Owner = types.StringValue(ds.Owner)
 			state.CreatedAt = types.StringValue(ds.CreatedAt.String())
 			state.OutputType = types.StringValue(ds.OutputType)
+
+			info, diags := readEntityInfo(ctx, r.apiClient, "output", ds.Identifier)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+			state.Owner = info.Owner
+			state.ContactIds = info.ContactIds
+			state.Links = info.Links
 			break
 		}
 	}
 
+	if !state.DataProductIds.IsNull() {
+		dataProductIds, err := r.linkedDataProductIds(state.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error Reading NEOS output",
+				"Could not read NEOS output links ID "+state.ID.ValueString()+": "+err.Error(),
+			)
+			return
+		}
+
+		dataProductSet, diags := SortStringArrayToList(dataProductIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		state.DataProductIds = dataProductSet
+	}
+
 	//	tsv, _ := state.ID.ToStringValue(ctx)
 	// Set refreshed state
 	//	tflog.Info(ctx, "££ READ iterate over list")
@@ -288,6 +360,13 @@ func (r *outputResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	var state outputResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	//tflog.Info(ctx, "££ Update After Create Get plan")
 	// i, e := plan.ID.ToStringValue(ctx)
 	// if e.HasError() {
@@ -326,7 +405,7 @@ func (r *outputResource) Update(ctx context.Context, req resource.UpdateRequest,
 			Name:        plan.Name.ValueString(),
 			Label:       plan.Label.ValueString(),
 			Description: plan.Description.ValueString(),
-			OutputType:  plan.OutputType.String(),
+			OutputType:  plan.OutputType.ValueString(),
 		},
 	}
 
@@ -355,6 +434,32 @@ func (r *outputResource) Update(ctx context.Context, req resource.UpdateRequest,
 		return
 	}
 
+	if !plan.DataProductIds.IsNull() {
+		diags, wanted := SortListValueIntoStringArray(ctx, plan.DataProductIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		previous := []string{}
+		if !state.DataProductIds.IsNull() {
+			diags, previous = SortListValueIntoStringArray(ctx, state.DataProductIds)
+			resp.Diagnostics.Append(diags...)
+			if resp.Diagnostics.HasError() {
+				return
+			}
+		}
+
+		err = r.syncDataProductLinks(ctx, plan.ID.ValueString(), wanted, previous)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error updating output",
+				"Could not link data products to output, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
 	contactsList, _ := types.ListValueFrom(ctx, types.StringType, infoResult.ContactIds)
 	linksList, _ := types.ListValueFrom(ctx, types.StringType, infoResult.Links)
 
@@ -394,6 +499,31 @@ func (r *outputResource) Delete(ctx context.Context, req resource.DeleteRequest,
 		return
 	}
 
+	if !plan.DataProductIds.IsNull() {
+		diags, previous := SortListValueIntoStringArray(ctx, plan.DataProductIds)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		err := r.syncDataProductLinks(ctx, plan.ID.ValueString(), []string{}, previous)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				"Error deleting output",
+				"Could not unlink data products from output, unexpected error: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if plan.ForceDestroy.ValueBool() {
+		err := deleteEntityLinks(ctx, r.linksClient, plan.ID.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error deleting output", "Could not remove the links of the output, unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	err := r.client.Delete(ctx, plan.ID.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
@@ -410,21 +540,78 @@ func (r *outputResource) Configure(_ context.Context, req resource.ConfigureRequ
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.OutputClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *neos.OutputClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
 	}
 
-	r.client = client
+	r.client = &client.OutputClient
+	r.linksClient = &client.LinksClient
+	r.apiClient = client.API
+}
+
+// linkedDataProductIds returns the ids of the data products linked to the output.
+func (r *outputResource) linkedDataProductIds(outputId string) ([]string, error) {
+	linksList, err := r.linksClient.Get()
+	if err != nil {
+		return nil, err
+	}
+
+	rtn := []string{}
+	for _, l := range linksList.Links {
+		if l.Child.Identifier == outputId && l.Parent.EntityType == "data_product" && !slices.Contains(rtn, l.Parent.Identifier) {
+			rtn = append(rtn, l.Parent.Identifier)
+		}
+	}
+	return rtn, nil
+}
+
+// syncDataProductLinks links each wanted data product to the output and
+// removes the links for data products that were previously managed but are no
+// longer wanted.
+func (r *outputResource) syncDataProductLinks(ctx context.Context, outputId string, wanted []string, previous []string) error {
+	linked, err := r.linkedDataProductIds(outputId)
+	if err != nil {
+		return err
+	}
+
+	for _, id := range wanted {
+		if slices.Contains(linked, id) {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("outputResource linking data product %s to output %s", id, outputId))
+		_, err := r.linksClient.LinkDataProductToOutput(ctx, id, outputId)
+		if err != nil {
+			return err
+		}
+	}
+
+	for _, id := range previous {
+		if slices.Contains(wanted, id) || !slices.Contains(linked, id) {
+			continue
+		}
+		tflog.Info(ctx, fmt.Sprintf("outputResource unlinking data product %s from output %s", id, outputId))
+		err := r.linksClient.DeleteLinkDataProductToOutput(ctx, id, outputId)
+		if err != nil {
+			return err
+		}
+	}
+
+	return nil
 }
 
+// ImportState imports the resource by its id, NEOS URN or name:<name>.
 func (r *outputResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importCatalogueEntity(ctx, req, resp, "output", func() ([]neos.Output, error) {
+		list, err := r.client.Get()
+		return list.Entities, err
+	}, func(e neos.Output) (string, string, string) {
+		return e.Identifier, e.Name, e.Urn
+	})
 }
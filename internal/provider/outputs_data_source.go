@@ -0,0 +1,199 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewOutputsDataSource() datasource.DataSource {
+	return &outputsDataSourceV2{}
+}
+
+var (
+	_ datasource.DataSource              = &outputsDataSourceV2{}
+	_ datasource.DataSourceWithConfigure = &outputsDataSourceV2{}
+)
+
+type outputsDataSourceV2 struct {
+	client    *neos.OutputClient
+	apiClient *neosAPIClient
+}
+
+func (d *outputsDataSourceV2) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_outputs"
+}
+
+func (d *outputsDataSourceV2) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "outputsDataSourceV2 READ")
+
+	var state OutputsDataSourceModelV2
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The client lists the entities of the provider account.
+	var list neos.OutputList
+	var err error
+	if state.Account.IsNull() {
+		list, err = d.client.Get()
+	} else {
+		err = d.apiClient.EntityList("output", state.Account.ValueString(), &list)
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Read Output List",
+			err.Error(),
+		)
+		return
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("outputsDataSourceV2 READ length %d", len(list.Entities)))
+
+	outputs := filterEntities(list.Entities, func(o neos.Output) bool {
+		if !state.Healthy.IsNull() && o.State.Healthy != state.Healthy.ValueBool() {
+			return false
+		}
+		return matchesFilter(state.Name, o.Name) && matchesFilter(state.Owner, o.Owner) && matchesFilter(state.OutputType, o.OutputType)
+	})
+
+	// Map response body to model
+	state.Outputs = []OutputModelV2{}
+	for _, o := range outputs {
+		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", o.Identifier))
+		state.Outputs = append(state.Outputs, newOutputModelV2(o))
+	}
+
+	// Set state
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *outputsDataSourceV2) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "outputsDataSourceV2 Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected outputsDataSourceV2 Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+
+		return
+	}
+
+	d.client = &client.OutputClient
+	d.apiClient = client.API
+}
+
+func (d *outputsDataSourceV2) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"account": pluralFilterAttribute("Only return outputs of this account, defaults to the provider account"),
+			"name": schema.StringAttribute{
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "Only return outputs with this name",
+			},
+			"owner": schema.StringAttribute{
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "Only return outputs with this owner",
+			},
+			"output_type": schema.StringAttribute{
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "Only return outputs of this type one of dashboard, application, api or file_export",
+				Validators: []validator.String{
+					stringvalidator.OneOf(outputTypes...),
+				},
+			},
+			"healthy": schema.BoolAttribute{
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "Only return outputs whose health matches",
+			},
+			"outputs": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The outputs matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: outputDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+// outputDataSourceAttributes are the computed attributes of an output shared by
+// the neos_output and neos_outputs data sources.
+func outputDataSourceAttributes() map[string]schema.Attribute {
+	rtn := catalogueEntityDataSourceAttributes()
+	rtn["output_type"] = schema.StringAttribute{
+		Computed: true,
+	}
+	return rtn
+}
+
+type OutputsDataSourceModelV2 struct {
+	Account    types.String    `tfsdk:"account"`
+	Name       types.String    `tfsdk:"name"`
+	Owner      types.String    `tfsdk:"owner"`
+	OutputType types.String    `tfsdk:"output_type"`
+	Healthy    types.Bool      `tfsdk:"healthy"`
+	Outputs    []OutputModelV2 `tfsdk:"outputs"`
+}
+
+// OutputModelV2 maps an output from the output list.
+type OutputModelV2 struct {
+	Identifier  types.String       `tfsdk:"id"`
+	Urn         types.String       `tfsdk:"urn"`
+	Name        types.String       `tfsdk:"name"`
+	Description types.String       `tfsdk:"description"`
+	Label       types.String       `tfsdk:"label"`
+	Owner       types.String       `tfsdk:"owner"`
+	CreatedAt   types.String       `tfsdk:"created_at"`
+	State       OutputStateModelV2 `tfsdk:"state"`
+	OutputType  types.String       `tfsdk:"output_type"`
+}
+
+type OutputStateModelV2 struct {
+	State   types.String `tfsdk:"state"`
+	Healthy types.Bool   `tfsdk:"healthy"`
+}
+
+func newOutputModelV2(o neos.Output) OutputModelV2 {
+	return OutputModelV2{
+		Identifier:  types.StringValue(o.Identifier),
+		Name:        types.StringValue(o.Name),
+		Description: types.StringValue(o.Description),
+		Label:       types.StringValue(o.Label),
+		Owner:       types.StringValue(o.Owner),
+		Urn:         types.StringValue(o.Urn),
+		CreatedAt:   types.StringValue(o.CreatedAt.String()),
+		OutputType:  types.StringValue(o.OutputType),
+		State: OutputStateModelV2{
+			State:   types.StringValue(o.State.State),
+			Healthy: types.BoolValue(o.State.Healthy),
+		},
+	}
+}
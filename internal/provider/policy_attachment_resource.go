@@ -0,0 +1,502 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	jt "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+	"golang.org/x/exp/slices"
+)
+
+// NewPolicyAttachmentResource is a helper function to simplify the provider implementation.
+func NewPolicyAttachmentResource() resource.Resource {
+	return &policyAttachmentResource{}
+}
+
+// policyAttachmentResource attaches the same policy to several users, NEOS
+// keeps one policy per user.
+type policyAttachmentResource struct {
+	client          *neos.PolicyClient
+	userClient      *neos.UserClient
+	providerAccount string
+}
+
+var (
+	_ resource.Resource                = &policyAttachmentResource{}
+	_ resource.ResourceWithConfigure   = &policyAttachmentResource{}
+	_ resource.ResourceWithModifyPlan  = &policyAttachmentResource{}
+	_ resource.ResourceWithImportState = &policyAttachmentResource{}
+)
+
+// Metadata returns the resource type name.
+func (r *policyAttachmentResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_attachment"
+}
+
+// Schema defines the schema for the resource.
+func (r *policyAttachmentResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    false,
+				Description: "The sorted principal IDs joined by commas",
+			},
+			"principals": schema.SetAttribute{
+				ElementType: types.StringType,
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The IDs of the users the policy is attached to, NEOS only keeps policies for users",
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"policy_json": schema.StringAttribute{
+				CustomType:  jt.NormalizedType{},
+				Computed:    false,
+				Required:    true,
+				Optional:    false,
+				Description: "The policy, for example neos_policy_document.json. The user of the policy is replaced by each principal and statements without a principal apply to the principal they are attached to",
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
+			},
+			"last_updated": schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+}
+
+// policyAttachmentResourceModel maps the resource schema data.
+type policyAttachmentResourceModel struct {
+	ID          types.String  `tfsdk:"id"`
+	Principals  types.Set     `tfsdk:"principals"`
+	Policy      jt.Normalized `tfsdk:"policy_json"`
+	Account     types.String  `tfsdk:"account"`
+	LastUpdated types.String  `tfsdk:"last_updated"`
+}
+
+// policyAttachmentID is the id of an attachment to the principals.
+func policyAttachmentID(principals []string) types.String {
+	sorted := slices.Clone(principals)
+	sort.Strings(sorted)
+	return types.StringValue(strings.Join(sorted, ","))
+}
+
+// Create a new resource.
+func (r *policyAttachmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan policyAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, principals := SortListValueIntoStringArray(ctx, plan.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	doc, err := parsePolicyDocument(plan.Policy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("policy_json"), "Invalid policy", "Could not parse policy: "+err.Error())
+		return
+	}
+
+	if !r.checkUsers(principals, plan.Account.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	attached := []string{}
+	for _, p := range principals {
+		err := r.attach(ctx, doc, p, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating policy attachment", "Could not attach policy to principal "+p+", unexpected error: "+err.Error())
+			r.saveAttached(ctx, plan, attached, resp)
+			return
+		}
+		attached = append(attached, p)
+	}
+
+	plan.ID = policyAttachmentID(principals)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Read refreshes the Terraform state with the latest data. Principals without
+// a policy are dropped, the policy of the first principal that differs from
+// the one in state is reported as drift. Statements of neos_data_product_grant
+// are not compared.
+func (r *policyAttachmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state policyAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+
+	diags, principals := SortListValueIntoStringArray(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	expected := ""
+	if !state.Policy.IsNull() {
+		doc, err := parsePolicyDocument(state.Policy.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("policy_json"), "Invalid policy", "Could not parse policy: "+err.Error())
+			return
+		}
+		doc, _ = doc.splitGrants()
+		expected, err = doc.withoutPrincipal(doc.User).json()
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading NEOS policy attachment", err.Error())
+			return
+		}
+	}
+
+	attached := []string{}
+	drift := ""
+	for _, p := range principals {
+		policy, err := r.client.Get(p, state.Account.ValueString())
+		if isNotFoundError(err) {
+			tflog.Info(ctx, fmt.Sprintf("principal %s no longer has a policy", p))
+			continue
+		}
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading NEOS policy attachment", "Could not read NEOS policy of principal "+p+": "+err.Error())
+			return
+		}
+		attached = append(attached, p)
+
+		doc, err := parsePolicyDocument(policy.Policy)
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading NEOS policy attachment", "Could not parse NEOS policy of principal "+p+": "+err.Error())
+			return
+		}
+		// The statements of neos_data_product_grant are kept by attach.
+		doc, _ = doc.splitGrants()
+		actual, err := doc.withoutPrincipal(p).json()
+		if err != nil {
+			resp.Diagnostics.AddError("Error Reading NEOS policy attachment", err.Error())
+			return
+		}
+		if actual != expected && drift == "" {
+			drift = actual
+		}
+	}
+
+	if len(attached) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	if drift != "" {
+		state.Policy = jt.NewNormalizedValue(drift)
+	}
+
+	state.Principals, diags = SortStringArrayToList(attached)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.ID = policyAttachmentID(attached)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Update updates the resource and sets the updated Terraform state on success.
+func (r *policyAttachmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan policyAttachmentResourceModel
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state policyAttachmentResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, principals := SortListValueIntoStringArray(ctx, plan.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, previous := SortListValueIntoStringArray(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	doc, err := parsePolicyDocument(plan.Policy.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddAttributeError(path.Root("policy_json"), "Invalid policy", "Could not parse policy: "+err.Error())
+		return
+	}
+
+	added := []string{}
+	for _, p := range principals {
+		if !slices.Contains(previous, p) {
+			added = append(added, p)
+		}
+	}
+	if len(added) > 0 && !r.checkUsers(added, plan.Account.ValueString(), &resp.Diagnostics) {
+		return
+	}
+
+	for _, p := range previous {
+		if slices.Contains(principals, p) {
+			continue
+		}
+		err := r.detach(ctx, p, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating policy attachment", "Could not detach policy from principal "+p+", unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	for _, p := range principals {
+		err := r.attach(ctx, doc, p, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating policy attachment", "Could not attach policy to principal "+p+", unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	plan.ID = policyAttachmentID(principals)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+
+	diags = resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// Delete deletes the resource and removes the Terraform state on success.
+func (r *policyAttachmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state policyAttachmentResourceModel
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags, principals := SortListValueIntoStringArray(ctx, state.Principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for _, p := range principals {
+		err := r.detach(ctx, p, state.Account.ValueString())
+		if err != nil && !isNotFoundError(err) {
+			resp.Diagnostics.AddError("Error deleting policy attachment", "Could not detach policy from principal "+p+", unexpected error: "+err.Error())
+			return
+		}
+	}
+}
+
+// attach puts the policy for the principal, it is posted when the principal
+// has no policy yet. The statements neos_data_product_grant manages in the
+// current policy are kept.
+func (r *policyAttachmentResource) attach(ctx context.Context, doc policyDocument, principal string, account string) error {
+	defer lockPrincipalPolicy(account, principal)()
+
+	doc, _ = doc.forPrincipal(principal).splitGrants()
+	grants, exists, err := r.grantStatements(principal, account)
+	if err != nil {
+		return err
+	}
+	doc.Policy.Statements = append(doc.Policy.Statements, grants...)
+
+	j, err := doc.json()
+	if err != nil {
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("attaching policy to principal %s", principal))
+	if exists {
+		_, err = r.client.Put(ctx, principal, neos.PolicyPutRequest{Policy: j}, account)
+		return err
+	}
+	_, err = r.client.Post(ctx, neos.PolicyPostRequest{Policy: j}, account)
+	return err
+}
+
+// detach deletes the policy of the principal, when neos_data_product_grant
+// manages statements in it only those are left.
+func (r *policyAttachmentResource) detach(ctx context.Context, principal string, account string) error {
+	defer lockPrincipalPolicy(account, principal)()
+
+	grants, exists, err := r.grantStatements(principal, account)
+	if err != nil || !exists {
+		return err
+	}
+
+	tflog.Info(ctx, fmt.Sprintf("detaching policy from principal %s", principal))
+	if len(grants) == 0 {
+		return r.client.Delete(ctx, principal, account)
+	}
+
+	doc := policyDocument{User: principal, Policy: policyBody{Version: policyDocumentVersion, Statements: grants}}
+	j, err := doc.json()
+	if err != nil {
+		return err
+	}
+	_, err = r.client.Put(ctx, principal, neos.PolicyPutRequest{Policy: j}, account)
+	return err
+}
+
+// grantStatements returns the statements neos_data_product_grant manages in
+// the current policy of the principal, exists is false when the principal has
+// no policy.
+func (r *policyAttachmentResource) grantStatements(principal string, account string) ([]policyStatement, bool, error) {
+	policy, err := r.client.Get(principal, account)
+	if isNotFoundError(err) {
+		return []policyStatement{}, false, nil
+	}
+	if err != nil {
+		return nil, false, err
+	}
+	current, err := parsePolicyDocument(policy.Policy)
+	if err != nil {
+		return nil, true, err
+	}
+	_, grants := current.splitGrants()
+	return grants, true, nil
+}
+
+// checkUsers reports the principals that are not users of the account, the
+// NEOS policy endpoint only takes users.
+func (r *policyAttachmentResource) checkUsers(principals []string, account string, diags *diag.Diagnostics) bool {
+	list, err := r.userClient.List("", "", account)
+	if err != nil {
+		diags.AddError("Error reading NEOS users", "Could not list the users of the account, unexpected error: "+err.Error())
+		return false
+	}
+	for _, p := range principals {
+		if !slices.ContainsFunc(list.Users, func(u neos.User) bool { return u.Identifier == p }) {
+			diags.AddAttributeError(path.Root("principals"), "Principal is not a user", fmt.Sprintf("Principal %s is not a user of the account, policies can only be attached to users.", p))
+		}
+	}
+	return !diags.HasError()
+}
+
+// saveAttached saves the principals the policy was attached to before a
+// create failed, so the next apply replaces the attachment and detaches them.
+func (r *policyAttachmentResource) saveAttached(ctx context.Context, plan policyAttachmentResourceModel, attached []string, resp *resource.CreateResponse) {
+	if len(attached) == 0 {
+		return
+	}
+
+	set, diags := SortStringArrayToList(attached)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	plan.Principals = set
+	plan.ID = policyAttachmentID(attached)
+	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *policyAttachmentResource) Configure(_ context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected Policy Attachment Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	r.client = &client.PolicyClient
+	r.userClient = &client.UserClient
+	r.providerAccount = client.Account
+}
+
+// ModifyPlan defaults the account to the provider's.
+func (r *policyAttachmentResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
+}
+
+// ImportState imports the attachment of a principal, several principals
+// sharing the same policy can be imported as a comma separated list. The list
+// may be prefixed with <account>/ and the principals may be NEOS URNs.
+func (r *policyAttachmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	account, list, found := strings.Cut(req.ID, "/")
+	if !found {
+		account, list = "", req.ID
+	}
+
+	principals := []string{}
+	for _, p := range strings.Split(list, ",") {
+		if p = strings.TrimSpace(p); p == "" {
+			continue
+		}
+		id, err := parseImportID(p, false)
+		if err != nil {
+			resp.Diagnostics.AddError("Invalid import ID", err.Error())
+			return
+		}
+		if account == "" {
+			account = id.Account
+		}
+		principals = append(principals, id.ID)
+	}
+	if len(principals) == 0 || (found && account == "") {
+		resp.Diagnostics.AddError("Invalid import ID", fmt.Sprintf("Expected an import ID of one or more principal IDs or NEOS URNs separated by commas, optionally prefixed with <account>/, got: %q", req.ID))
+		return
+	}
+
+	set, diags := SortStringArrayToList(principals)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), policyAttachmentID(principals))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("principals"), set)...)
+	if account != "" {
+		resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), account)...)
+	}
+}
@@ -0,0 +1,249 @@
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// policyDocumentVersion is the policy language version NEOS currently accepts.
+const policyDocumentVersion = "2022-10-01"
+
+// policyStatement is a single statement of a NEOS IAM policy, it mirrors the
+// anonymous statement type of neos.UserPolicyType so documents can be built
+// and inspected without going through the client.
+type policyStatement struct {
+	Sid       string   `json:"sid"`
+	Principal string   `json:"principal"`
+	Action    []string `json:"action"`
+	Resource  []string `json:"resource"`
+	Condition []string `json:"condition"`
+	Effect    string   `json:"effect"`
+}
+
+type policyBody struct {
+	Version    string            `json:"version"`
+	Statements []policyStatement `json:"statements"`
+}
+
+// policyDocument is a NEOS user policy as accepted by neos_user_policy.policy_json.
+type policyDocument struct {
+	User     string     `json:"user"`
+	Policy   policyBody `json:"policy"`
+	IsSystem bool       `json:"is_system"`
+}
+
+// parsePolicyDocument parses the JSON of a NEOS user policy.
+func parsePolicyDocument(j string) (policyDocument, error) {
+	doc := policyDocument{}
+	err := json.Unmarshal([]byte(j), &doc)
+	if err != nil {
+		return doc, err
+	}
+	for i := range doc.Policy.Statements {
+		doc.Policy.Statements[i].fillEmpty()
+	}
+	return doc, nil
+}
+
+// fillEmpty replaces nil lists with empty ones so they are rendered as [] like
+// NEOS returns them.
+func (s *policyStatement) fillEmpty() {
+	if s.Action == nil {
+		s.Action = []string{}
+	}
+	if s.Resource == nil {
+		s.Resource = []string{}
+	}
+	if s.Condition == nil {
+		s.Condition = []string{}
+	}
+}
+
+// mergeStatements adds statements to the document, a statement with the same
+// sid as an existing one replaces it and the others are appended.
+func (d *policyDocument) mergeStatements(statements []policyStatement) {
+	for _, s := range statements {
+		replaced := false
+		if s.Sid != "" {
+			for i := range d.Policy.Statements {
+				if d.Policy.Statements[i].Sid == s.Sid {
+					d.Policy.Statements[i] = s
+					replaced = true
+					break
+				}
+			}
+		}
+		if !replaced {
+			d.Policy.Statements = append(d.Policy.Statements, s)
+		}
+	}
+}
+
+// mergeHeader copies the user, version and is_system of other into the
+// document when other sets them.
+func (d *policyDocument) mergeHeader(other policyDocument) {
+	if other.User != "" {
+		d.User = other.User
+	}
+	if other.Policy.Version != "" {
+		d.Policy.Version = other.Policy.Version
+	}
+	if other.IsSystem {
+		d.IsSystem = true
+	}
+}
+
+// appendSourceStatements appends the statements of a source document, unlike
+// mergeStatements a sid that is already in the document is an error as the
+// sources would silently shadow each other.
+func (d *policyDocument) appendSourceStatements(statements []policyStatement) error {
+	for _, s := range statements {
+		if s.Sid != "" {
+			for _, existing := range d.Policy.Statements {
+				if existing.Sid == s.Sid {
+					return fmt.Errorf("duplicate statement sid %q in source policy documents, use override_policy_documents to replace a statement", s.Sid)
+				}
+			}
+		}
+		d.Policy.Statements = append(d.Policy.Statements, s)
+	}
+	return nil
+}
+
+// wildcardMatch reports if value matches pattern where * in the pattern
+// matches any run of characters, including the : separating URN parts.
+func wildcardMatch(pattern string, value string) bool {
+	star := strings.IndexByte(pattern, '*')
+	if star < 0 {
+		return pattern == value
+	}
+	if !strings.HasPrefix(value, pattern[:star]) {
+		return false
+	}
+	rest := pattern[star+1:]
+	for i := star; i <= len(value); i++ {
+		if wildcardMatch(rest, value[i:]) {
+			return true
+		}
+	}
+	return false
+}
+
+func wildcardMatchAny(patterns []string, value string) bool {
+	for _, p := range patterns {
+		if wildcardMatch(p, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// appliesTo reports if the statement covers the principal, action and resource.
+func (s policyStatement) appliesTo(principal string, action string, resource string) bool {
+	return (s.Principal == "" || wildcardMatch(s.Principal, principal)) &&
+		wildcardMatchAny(s.Action, action) &&
+		wildcardMatchAny(s.Resource, resource)
+}
+
+// policyDecision is the result of evaluating policy documents for a request.
+type policyDecision struct {
+	Allowed   bool
+	Decision  string
+	Statement *policyStatement
+	// Skipped are the sids of matching statements that were ignored because
+	// their conditions cannot be evaluated offline.
+	Skipped []string
+}
+
+// evaluatePolicies evaluates the statements of the documents for a request.
+// An explicit deny wins over any allow and no matching statement is an
+// implicit deny.
+func evaluatePolicies(docs []policyDocument, principal string, action string, resource string) policyDecision {
+	decision := policyDecision{Decision: "implicit_deny", Skipped: []string{}}
+	for _, doc := range docs {
+		for i := range doc.Policy.Statements {
+			s := doc.Policy.Statements[i]
+			if s.Principal == "" {
+				s.Principal = doc.User
+			}
+			if !s.appliesTo(principal, action, resource) {
+				continue
+			}
+			if len(s.Condition) > 0 {
+				decision.Skipped = append(decision.Skipped, s.Sid)
+				continue
+			}
+			switch {
+			case strings.EqualFold(s.Effect, "deny"):
+				return policyDecision{Allowed: false, Decision: "deny", Statement: &s, Skipped: decision.Skipped}
+			case strings.EqualFold(s.Effect, "allow") && decision.Statement == nil:
+				decision.Allowed = true
+				decision.Decision = "allow"
+				decision.Statement = &s
+			}
+		}
+	}
+	return decision
+}
+
+// forPrincipal returns a copy of the document attached to principal, the
+// statements without a principal apply to it.
+func (d policyDocument) forPrincipal(principal string) policyDocument {
+	rtn := d
+	rtn.User = principal
+	rtn.Policy.Statements = make([]policyStatement, len(d.Policy.Statements))
+	for i, s := range d.Policy.Statements {
+		if s.Principal == "" {
+			s.Principal = principal
+		}
+		rtn.Policy.Statements[i] = s
+	}
+	return rtn
+}
+
+// withoutPrincipal reverses forPrincipal so the documents attached to
+// different principals can be compared.
+func (d policyDocument) withoutPrincipal(principal string) policyDocument {
+	rtn := d
+	rtn.User = ""
+	rtn.Policy.Statements = make([]policyStatement, len(d.Policy.Statements))
+	for i, s := range d.Policy.Statements {
+		if s.Principal == principal {
+			s.Principal = ""
+		}
+		rtn.Policy.Statements[i] = s
+	}
+	return rtn
+}
+
+// dataProductGrantSidPrefix starts the sid of every statement managed by a
+// neos_data_product_grant.
+const dataProductGrantSidPrefix = "data-product-grant-"
+
+// isGrant says if the statement is managed by a neos_data_product_grant.
+func (s policyStatement) isGrant() bool {
+	return strings.HasPrefix(s.Sid, dataProductGrantSidPrefix)
+}
+
+// splitGrants returns the document without the statements managed by
+// neos_data_product_grant and those statements.
+func (d policyDocument) splitGrants() (policyDocument, []policyStatement) {
+	rtn := d
+	rtn.Policy.Statements = []policyStatement{}
+	grants := []policyStatement{}
+	for _, s := range d.Policy.Statements {
+		if s.isGrant() {
+			grants = append(grants, s)
+		} else {
+			rtn.Policy.Statements = append(rtn.Policy.Statements, s)
+		}
+	}
+	return rtn, grants
+}
+
+// json returns the document as JSON.
+func (d policyDocument) json() (string, error) {
+	b, err := json.Marshal(d)
+	return string(b), err
+}
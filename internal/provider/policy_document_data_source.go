@@ -0,0 +1,296 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	jt "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewPolicyDocumentDataSource() datasource.DataSource {
+	return &policyDocumentDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &policyDocumentDataSource{}
+	_ datasource.DataSourceWithConfigure = &policyDocumentDataSource{}
+)
+
+// policyDocumentDataSource builds a NEOS user policy from HCL, nothing is read
+// from NEOS.
+type policyDocumentDataSource struct {
+	client *neos.PolicyClient
+}
+
+type policyDocumentDataSourceModel struct {
+	User                    types.String                   `tfsdk:"user"`
+	Version                 types.String                   `tfsdk:"version"`
+	IsSystem                types.Bool                     `tfsdk:"is_system"`
+	SourcePolicyDocuments   types.List                     `tfsdk:"source_policy_documents"`
+	OverridePolicyDocuments types.List                     `tfsdk:"override_policy_documents"`
+	Statements              []policyDocumentStatementModel `tfsdk:"statement"`
+	Json                    jt.Normalized                  `tfsdk:"json"`
+}
+
+type policyDocumentStatementModel struct {
+	Sid        types.String `tfsdk:"sid"`
+	Effect     types.String `tfsdk:"effect"`
+	Principal  types.String `tfsdk:"principal"`
+	Actions    types.List   `tfsdk:"actions"`
+	Resources  types.List   `tfsdk:"resources"`
+	Conditions types.List   `tfsdk:"conditions"`
+}
+
+func (d *policyDocumentDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_document"
+}
+
+func (d *policyDocumentDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Builds a NEOS user policy document that can be used as neos_user_policy.policy_json",
+		Attributes: map[string]schema.Attribute{
+			"user": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The ID of the user the policy belongs to, statements without a principal use it as their principal. Defaults to the user of the source or override documents",
+			},
+			"version": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The policy language version, defaults to " + policyDocumentVersion,
+			},
+			"is_system": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "Is system, defaults to false",
+			},
+			"source_policy_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "Policy documents whose statements are added before the statement blocks. Statement sids must be unique across the source documents, a statement block with the same sid replaces the source statement",
+			},
+			"override_policy_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    false,
+				Optional:    true,
+				Required:    false,
+				Description: "Policy documents applied in order after the statement blocks, a statement with the same sid as an existing one replaces it and other statements are appended",
+			},
+			"json": schema.StringAttribute{
+				CustomType:  jt.NormalizedType{},
+				Computed:    true,
+				Optional:    false,
+				Required:    false,
+				Description: "The normalized policy JSON",
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"statement": schema.ListNestedBlock{
+				Description: "A statement of the policy",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"sid": schema.StringAttribute{
+							Computed:    false,
+							Optional:    true,
+							Required:    false,
+							Description: "The statement ID, used to replace statements of source documents",
+						},
+						"effect": schema.StringAttribute{
+							Computed:    false,
+							Optional:    true,
+							Required:    false,
+							Description: "Either allow or deny, defaults to allow",
+							Validators: []validator.String{
+								stringvalidator.OneOf("allow", "deny"),
+							},
+						},
+						"principal": schema.StringAttribute{
+							Computed:    false,
+							Optional:    true,
+							Required:    false,
+							Description: "The principal the statement applies to, defaults to user",
+						},
+						"actions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    false,
+							Optional:    false,
+							Required:    true,
+							Description: "The actions the statement allows or denies, for example product:browse or product:*",
+						},
+						"resources": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    false,
+							Optional:    false,
+							Required:    true,
+							Description: "The URNs of the resources the statement applies to, * matches any characters",
+						},
+						"conditions": schema.ListAttribute{
+							ElementType: types.StringType,
+							Computed:    false,
+							Optional:    true,
+							Required:    false,
+							Description: "Conditions of the statement",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *policyDocumentDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "policyDocumentDataSource READ")
+
+	var state policyDocumentDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	doc := policyDocument{Policy: policyBody{Statements: []policyStatement{}}}
+
+	sources := []string{}
+	if !state.SourcePolicyDocuments.IsNull() {
+		resp.Diagnostics.Append(state.SourcePolicyDocuments.ElementsAs(ctx, &sources, false)...)
+	}
+	overrides := []string{}
+	if !state.OverridePolicyDocuments.IsNull() {
+		resp.Diagnostics.Append(state.OverridePolicyDocuments.ElementsAs(ctx, &overrides, false)...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	for i, j := range sources {
+		source, err := parsePolicyDocument(j)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source_policy_documents").AtListIndex(i), "Invalid source policy document", "Could not parse policy document: "+err.Error())
+			return
+		}
+		doc.mergeHeader(source)
+		err = doc.appendSourceStatements(source.Policy.Statements)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("source_policy_documents").AtListIndex(i), "Invalid source policy document", err.Error())
+			return
+		}
+	}
+
+	if !state.User.IsNull() && !state.User.IsUnknown() {
+		doc.User = state.User.ValueString()
+	}
+	if !state.Version.IsNull() && !state.Version.IsUnknown() {
+		doc.Policy.Version = state.Version.ValueString()
+	}
+	if !state.IsSystem.IsNull() && !state.IsSystem.IsUnknown() {
+		doc.IsSystem = state.IsSystem.ValueBool()
+	}
+
+	statements := []policyStatement{}
+	for _, s := range state.Statements {
+		statement, diags := s.toPolicyStatement(ctx)
+		resp.Diagnostics.Append(diags...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		statements = append(statements, statement)
+	}
+	doc.mergeStatements(statements)
+
+	for i, j := range overrides {
+		override, err := parsePolicyDocument(j)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("override_policy_documents").AtListIndex(i), "Invalid override policy document", "Could not parse policy document: "+err.Error())
+			return
+		}
+		doc.mergeHeader(override)
+		doc.mergeStatements(override.Policy.Statements)
+	}
+
+	if doc.Policy.Version == "" {
+		doc.Policy.Version = policyDocumentVersion
+	}
+	for i := range doc.Policy.Statements {
+		if doc.Policy.Statements[i].Principal == "" {
+			doc.Policy.Statements[i].Principal = doc.User
+		}
+	}
+
+	b, err := json.Marshal(doc)
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to build policy document", err.Error())
+		return
+	}
+
+	normalized, err := d.client.NormalizeJson(string(b))
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to normalize policy document", err.Error())
+		return
+	}
+
+	state.User = types.StringValue(doc.User)
+	state.Version = types.StringValue(doc.Policy.Version)
+	state.IsSystem = types.BoolValue(doc.IsSystem)
+	state.Json = jt.NewNormalizedValue(normalized)
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+// toPolicyStatement converts a statement block into a policy statement.
+func (s policyDocumentStatementModel) toPolicyStatement(ctx context.Context) (policyStatement, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	statement := policyStatement{
+		Sid:       s.Sid.ValueString(),
+		Principal: s.Principal.ValueString(),
+		Effect:    s.Effect.ValueString(),
+	}
+	if statement.Effect == "" {
+		statement.Effect = "allow"
+	}
+
+	diags.Append(s.Actions.ElementsAs(ctx, &statement.Action, false)...)
+	diags.Append(s.Resources.ElementsAs(ctx, &statement.Resource, false)...)
+	if !s.Conditions.IsNull() {
+		diags.Append(s.Conditions.ElementsAs(ctx, &statement.Condition, false)...)
+	}
+	statement.fillEmpty()
+	return statement, diags
+}
+
+// Configure adds the provider configured client to the data source.
+func (d *policyDocumentDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "policyDocumentDataSource Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected policyDocumentDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+
+		return
+	}
+
+	d.client = &client.PolicyClient
+}
@@ -0,0 +1,238 @@
+package provider
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestWildcardMatch(t *testing.T) {
+	tests := []struct {
+		name    string
+		pattern string
+		value   string
+		want    bool
+	}{
+		{name: "exact", pattern: "core:data_product:read", value: "core:data_product:read", want: true},
+		{name: "exact mismatch", pattern: "core:data_product:read", value: "core:data_product:write", want: false},
+		{name: "star matches all", pattern: "*", value: "anything:at:all", want: true},
+		{name: "star matches empty", pattern: "*", value: "", want: true},
+		{name: "trailing star", pattern: "core:data_product:*", value: "core:data_product:read", want: true},
+		{name: "trailing star crosses separators", pattern: "nrn:ksa:core:*", value: "nrn:ksa:core:abc:data_product:1", want: true},
+		{name: "trailing star needs prefix", pattern: "core:data_unit:*", value: "core:data_product:read", want: false},
+		{name: "leading star", pattern: "*:read", value: "core:data_product:read", want: true},
+		{name: "leading star needs suffix", pattern: "*:read", value: "core:data_product:write", want: false},
+		{name: "middle star", pattern: "core:*:read", value: "core:data_product:read", want: true},
+		{name: "middle star matches empty", pattern: "core:*:read", value: "core::read", want: true},
+		{name: "several stars", pattern: "nrn:*:core:*:data_product:*", value: "nrn:ksa:core:abc:data_product:1", want: true},
+		{name: "several stars out of order", pattern: "*data_product*core*", value: "nrn:ksa:core:abc:data_product:1", want: false},
+		{name: "pattern longer than value", pattern: "core:data_product:read:*", value: "core:data_product:read", want: false},
+		{name: "case sensitive", pattern: "core:Data_Product:*", value: "core:data_product:read", want: false},
+		{name: "empty pattern", pattern: "", value: "core", want: false},
+		{name: "empty pattern and value", pattern: "", value: "", want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := wildcardMatch(tt.pattern, tt.value); got != tt.want {
+				t.Errorf("wildcardMatch(%q, %q) = %v, want %v", tt.pattern, tt.value, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestEvaluatePolicies(t *testing.T) {
+	const (
+		user     = "user-1"
+		other    = "user-2"
+		product  = "nrn:ksa:core:abc:data_product:1"
+		readOnly = "core:data_product:read"
+	)
+
+	statement := func(sid string, effect string, action string, resource string) policyStatement {
+		return policyStatement{
+			Sid:       sid,
+			Action:    []string{action},
+			Resource:  []string{resource},
+			Condition: []string{},
+			Effect:    effect,
+		}
+	}
+	document := func(principal string, statements ...policyStatement) policyDocument {
+		return policyDocument{User: principal, Policy: policyBody{Version: policyDocumentVersion, Statements: statements}}
+	}
+	conditional := statement("conditional", "allow", "*", "*")
+	conditional.Condition = []string{"ip_address == 10.0.0.1"}
+	otherPrincipal := statement("other", "allow", "*", "*")
+	otherPrincipal.Principal = other
+
+	tests := []struct {
+		name            string
+		docs            []policyDocument
+		action          string
+		want            string
+		wantSid         string
+		wantSkipped     []string
+		wantNoStatement bool
+	}{
+		{
+			name:            "no documents is an implicit deny",
+			docs:            []policyDocument{},
+			action:          readOnly,
+			want:            "implicit_deny",
+			wantSkipped:     []string{},
+			wantNoStatement: true,
+		},
+		{
+			name:        "matching allow",
+			docs:        []policyDocument{document(user, statement("read", "allow", readOnly, product))},
+			action:      readOnly,
+			want:        "allow",
+			wantSid:     "read",
+			wantSkipped: []string{},
+		},
+		{
+			name:        "wildcard allow",
+			docs:        []policyDocument{document(user, statement("all", "allow", "core:*", "nrn:ksa:core:abc:*"))},
+			action:      "core:data_product:write",
+			want:        "allow",
+			wantSid:     "all",
+			wantSkipped: []string{},
+		},
+		{
+			name:            "action not covered",
+			docs:            []policyDocument{document(user, statement("read", "allow", readOnly, product))},
+			action:          "core:data_product:write",
+			want:            "implicit_deny",
+			wantSkipped:     []string{},
+			wantNoStatement: true,
+		},
+		{
+			name:            "resource not covered",
+			docs:            []policyDocument{document(user, statement("read", "allow", readOnly, "nrn:ksa:core:abc:data_product:2"))},
+			action:          readOnly,
+			want:            "implicit_deny",
+			wantSkipped:     []string{},
+			wantNoStatement: true,
+		},
+		{
+			name: "deny wins over an earlier allow",
+			docs: []policyDocument{document(user,
+				statement("read", "allow", readOnly, product),
+				statement("block", "deny", "*", product),
+			)},
+			action:      readOnly,
+			want:        "deny",
+			wantSid:     "block",
+			wantSkipped: []string{},
+		},
+		{
+			name: "deny in another document wins",
+			docs: []policyDocument{
+				document(user, statement("read", "allow", readOnly, product)),
+				document(user, statement("block", "DENY", readOnly, "*")),
+			},
+			action:      readOnly,
+			want:        "deny",
+			wantSid:     "block",
+			wantSkipped: []string{},
+		},
+		{
+			name: "first allow is reported",
+			docs: []policyDocument{document(user,
+				statement("first", "allow", "*", "*"),
+				statement("second", "allow", readOnly, product),
+			)},
+			action:      readOnly,
+			want:        "allow",
+			wantSid:     "first",
+			wantSkipped: []string{},
+		},
+		{
+			name:            "statements of another principal do not apply",
+			docs:            []policyDocument{document(user, otherPrincipal)},
+			action:          readOnly,
+			want:            "implicit_deny",
+			wantSkipped:     []string{},
+			wantNoStatement: true,
+		},
+		{
+			name:            "documents of another principal do not apply",
+			docs:            []policyDocument{document(other, statement("read", "allow", readOnly, product))},
+			action:          readOnly,
+			want:            "implicit_deny",
+			wantSkipped:     []string{},
+			wantNoStatement: true,
+		},
+		{
+			name:            "conditional statements are skipped",
+			docs:            []policyDocument{document(user, conditional)},
+			action:          readOnly,
+			want:            "implicit_deny",
+			wantSkipped:     []string{"conditional"},
+			wantNoStatement: true,
+		},
+		{
+			name: "conditional statements are skipped alongside an allow",
+			docs: []policyDocument{document(user,
+				conditional,
+				statement("read", "allow", readOnly, product),
+			)},
+			action:      readOnly,
+			want:        "allow",
+			wantSid:     "read",
+			wantSkipped: []string{"conditional"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := evaluatePolicies(tt.docs, user, tt.action, product)
+			if got.Decision != tt.want {
+				t.Errorf("Decision = %q, want %q", got.Decision, tt.want)
+			}
+			if got.Allowed != (tt.want == "allow") {
+				t.Errorf("Allowed = %v for decision %q", got.Allowed, got.Decision)
+			}
+			if tt.wantNoStatement {
+				if got.Statement != nil {
+					t.Errorf("Statement = %q, want none", got.Statement.Sid)
+				}
+			} else if got.Statement == nil || got.Statement.Sid != tt.wantSid {
+				t.Errorf("Statement = %v, want sid %q", got.Statement, tt.wantSid)
+			}
+			if !reflect.DeepEqual(got.Skipped, tt.wantSkipped) {
+				t.Errorf("Skipped = %v, want %v", got.Skipped, tt.wantSkipped)
+			}
+		})
+	}
+}
+
+func TestPolicyDocumentSplitGrants(t *testing.T) {
+	statement := func(sid string) policyStatement {
+		return policyStatement{Sid: sid, Action: []string{"*"}, Resource: []string{"*"}, Condition: []string{}, Effect: "allow"}
+	}
+	doc := policyDocument{User: "user-1", Policy: policyBody{Version: policyDocumentVersion, Statements: []policyStatement{
+		statement("read"),
+		statement(dataProductGrantSid("dp-1")),
+		statement("grant"),
+		statement(dataProductGrantSid("dp-2")),
+	}}}
+
+	rest, grants := doc.splitGrants()
+	sids := func(statements []policyStatement) []string {
+		rtn := []string{}
+		for _, s := range statements {
+			rtn = append(rtn, s.Sid)
+		}
+		return rtn
+	}
+	if got, want := sids(rest.Policy.Statements), []string{"read", "grant"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("statements = %v, want %v", got, want)
+	}
+	if got, want := sids(grants), []string{"data-product-grant-dp-1", "data-product-grant-dp-2"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("grants = %v, want %v", got, want)
+	}
+	if len(doc.Policy.Statements) != 4 || rest.User != "user-1" {
+		t.Errorf("splitGrants changed the document or dropped its header")
+	}
+}
@@ -0,0 +1,17 @@
+package provider
+
+import "sync"
+
+// principalPolicyLocks holds a mutex per account and principal, the policy of
+// a principal is a single document so the resources that change part of it
+// must not read and write it at the same time.
+var principalPolicyLocks sync.Map
+
+// lockPrincipalPolicy locks the policy of the principal in the account and
+// returns the function that unlocks it.
+func lockPrincipalPolicy(account string, principal string) func() {
+	m, _ := principalPolicyLocks.LoadOrStore(account+"/"+principal, &sync.Mutex{})
+	mu := m.(*sync.Mutex)
+	mu.Lock()
+	return mu.Unlock
+}
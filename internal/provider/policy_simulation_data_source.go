@@ -0,0 +1,172 @@
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	jt "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+func NewPolicySimulationDataSource() datasource.DataSource {
+	return &policySimulationDataSource{}
+}
+
+var (
+	_ datasource.DataSource = &policySimulationDataSource{}
+)
+
+// policySimulationDataSource evaluates policy documents offline, it does not
+// call NEOS so it needs no client.
+type policySimulationDataSource struct {
+}
+
+type policySimulationDataSourceModel struct {
+	PolicyDocuments      types.List    `tfsdk:"policy_documents"`
+	Principal            types.String  `tfsdk:"principal"`
+	Action               types.String  `tfsdk:"action"`
+	Resource             types.String  `tfsdk:"resource"`
+	Allowed              types.Bool    `tfsdk:"allowed"`
+	Decision             types.String  `tfsdk:"decision"`
+	MatchedStatementSid  types.String  `tfsdk:"matched_statement_sid"`
+	MatchedStatement     jt.Normalized `tfsdk:"matched_statement"`
+	SkippedStatementSids types.List    `tfsdk:"skipped_statement_sids"`
+}
+
+func (d *policySimulationDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_policy_simulation"
+}
+
+func (d *policySimulationDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Description: "Evaluates NEOS user policy documents offline for a principal, action and resource",
+		Attributes: map[string]schema.Attribute{
+			"policy_documents": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    false,
+				Optional:    false,
+				Required:    true,
+				Description: "The policy documents to evaluate, for example neos_user_policy.policy_json or neos_policy_document.json",
+			},
+			"principal": schema.StringAttribute{
+				Computed:    false,
+				Optional:    false,
+				Required:    true,
+				Description: "The ID of the principal making the request",
+			},
+			"action": schema.StringAttribute{
+				Computed:    false,
+				Optional:    false,
+				Required:    true,
+				Description: "The action being requested, for example product:consume",
+			},
+			"resource": schema.StringAttribute{
+				Computed:    false,
+				Optional:    false,
+				Required:    true,
+				Description: "The URN of the resource being requested",
+			},
+			"allowed": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    false,
+				Required:    false,
+				Description: "If the request is allowed",
+			},
+			"decision": schema.StringAttribute{
+				Computed:    true,
+				Optional:    false,
+				Required:    false,
+				Description: "allow, deny when a statement explicitly denies the request, or implicit_deny when no statement matches",
+			},
+			"matched_statement_sid": schema.StringAttribute{
+				Computed:    true,
+				Optional:    false,
+				Required:    false,
+				Description: "The sid of the statement that decided the request, empty for an implicit deny",
+			},
+			"matched_statement": schema.StringAttribute{
+				CustomType:  jt.NormalizedType{},
+				Computed:    true,
+				Optional:    false,
+				Required:    false,
+				Description: "The JSON of the statement that decided the request, null for an implicit deny",
+			},
+			"skipped_statement_sids": schema.ListAttribute{
+				ElementType: types.StringType,
+				Computed:    true,
+				Optional:    false,
+				Required:    false,
+				Description: "The sids of matching statements that were ignored because their conditions cannot be evaluated offline",
+			},
+		},
+	}
+}
+
+func (d *policySimulationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "policySimulationDataSource READ")
+
+	var state policySimulationDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	documents := []string{}
+	resp.Diagnostics.Append(state.PolicyDocuments.ElementsAs(ctx, &documents, false)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	docs := []policyDocument{}
+	for i, j := range documents {
+		doc, err := parsePolicyDocument(j)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("policy_documents").AtListIndex(i), "Invalid policy document", "Could not parse policy document: "+err.Error())
+			return
+		}
+		docs = append(docs, doc)
+	}
+
+	decision := evaluatePolicies(docs, state.Principal.ValueString(), state.Action.ValueString(), state.Resource.ValueString())
+
+	if len(decision.Skipped) > 0 {
+		resp.Diagnostics.AddWarning(
+			"Policy conditions not evaluated",
+			fmt.Sprintf("The statements %s match the request but have conditions, they were ignored as conditions cannot be evaluated offline.", strings.Join(decision.Skipped, ", ")),
+		)
+	}
+
+	state.Allowed = types.BoolValue(decision.Allowed)
+	state.Decision = types.StringValue(decision.Decision)
+	state.MatchedStatementSid = types.StringValue("")
+	state.MatchedStatement = jt.NewNormalizedNull()
+	if decision.Statement != nil {
+		b, err := json.Marshal(decision.Statement)
+		if err != nil {
+			resp.Diagnostics.AddError("Unable to marshal matched statement", err.Error())
+			return
+		}
+		state.MatchedStatementSid = types.StringValue(decision.Statement.Sid)
+		state.MatchedStatement = jt.NewNormalizedValue(string(b))
+	}
+
+	state.SkippedStatementSids, diags = types.ListValueFrom(ctx, types.StringType, decision.Skipped)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
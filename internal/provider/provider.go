@@ -39,6 +39,16 @@ type neosProviderModel struct {
 	Password types.String `tfsdk:"password"`
 }
 
+// neosProviderData is handed to every data source and resource, it embeds the
+// neos-client-go clients and carries the provider level account and partition
+// along with the client for endpoints neos-client-go does not cover.
+type neosProviderData struct {
+	neos.NeosClient
+	Account   string
+	Partition string
+	API       *neosAPIClient
+}
+
 // neosProvider is the provider implementation.
 type neosProvider struct {
 	// version is set to the provider version on release, "dev" when the
@@ -293,24 +303,56 @@ func (p *neosProvider) Configure(ctx context.Context, req provider.ConfigureRequ
 		return
 	}
 
+	apiClient, err := newNeosAPIClient(hubhost, corehost, "https", account, partition)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unable to Create NEOS API Client",
+			"An unexpected error occurred when creating the NEOS API client. "+
+				"If the error is not clear, please contact the provider developers.\n\n"+
+				"NEOS Client Error: "+err.Error(),
+		)
+		return
+	}
+
+	providerData := &neosProviderData{
+		NeosClient: client,
+		Account:    account,
+		Partition:  partition,
+		API:        apiClient,
+	}
+
 	// Make the NEOS client available during DataSource and Resource
 	// type Configure methods.
-	resp.DataSourceData = &client
-	resp.ResourceData = &client
+	resp.DataSourceData = providerData
+	resp.ResourceData = providerData
 }
 
 // DataSources defines the data sources implemented in the provider.
 func (p *neosProvider) DataSources(_ context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
 		NewAccountDataSource,
+		NewAccountsDataSource,
 		NewDataSystemDataSource,
+		NewDataSystemsDataSource,
 		NewDataProductDataSource,
+		NewDataProductSchemaDataSource,
+		NewDataProductsDataSource,
 		NewDataSourceDataSource,
+		NewDataSourcesDataSource,
 		NewDataUnitDataSource,
+		NewDataUnitsDataSource,
 		NewGroupDataSource,
+		NewGroupsDataSource,
+		NewLineageDataSource,
 		NewLinksDataSource,
+		NewOutputDataSource,
+		NewOutputsDataSource,
+		NewPolicyDocumentDataSource,
+		NewPolicySimulationDataSource,
 		NewRegistryCoreDataSource,
+		NewRegistryCoresDataSource,
 		NewUserDataSource,
+		NewUsersDataSource,
 		NewUserPolicyDataSource,
 	}
 }
@@ -319,17 +361,23 @@ func (p *neosProvider) Resources(_ context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
 		NewAccountResource,
 		NewDataProductResource,
+		NewDataProductSchemaResource,
 		NewDataProductBuilderResource,
+		NewDataProductGrantResource,
 		NewDataSourceResource,
 		NewDataSystemResource,
 		NewDataUnitResource,
 		NewGroupResource,
+		NewGroupMembershipResource,
+		NewGroupMembersResource,
+		NewLinkResource,
 		NewLinkDataSourceDataUnitResource,
 		NewLinkDataSystemDataSourceResource,
 		NewLinkDataUnitDataProductResource,
 		NewLinkDataProductOutputResource,
 		NewLinkDataProductDataProductResource,
 		NewOutputResource,
+		NewPolicyAttachmentResource,
 		NewRegistryCoreResource,
 		NewSecretResource,
 		NewUserResource,
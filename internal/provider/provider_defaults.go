@@ -0,0 +1,63 @@
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// planProviderDefault sets attr in the plan to the provider's value when it is
+// not in the configuration, and replaces the resource when the planned value
+// differs from the one in state.
+//
+// State written before attr defaulted to the provider has it null, the
+// requests were already made with the provider's account and partition then
+// so null counts as the provider's value rather than a change.
+func planProviderDefault(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse, attr string, value string) {
+	// Nothing to plan when the resource is destroyed.
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	p := path.Root(attr)
+
+	var config types.String
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, p, &config)...)
+	if resp.Diagnostics.HasError() || config.IsUnknown() {
+		return
+	}
+
+	planned := config
+	if config.IsNull() {
+		planned = types.StringValue(value)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, p, planned)...)
+	}
+
+	if req.State.Raw.IsNull() {
+		return
+	}
+
+	var state types.String
+	resp.Diagnostics.Append(req.State.GetAttribute(ctx, p, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if state.IsNull() {
+		state = types.StringValue(value)
+	}
+
+	if !planned.Equal(state) {
+		resp.RequiresReplace = append(resp.RequiresReplace, p)
+	}
+}
+
+// stateProviderDefault returns value when the attribute in state is null, so
+// state written before the attribute defaulted to the provider is filled in.
+func stateProviderDefault(v types.String, value string) types.String {
+	if v.IsNull() {
+		return types.StringValue(value)
+	}
+	return v
+}
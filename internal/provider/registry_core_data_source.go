@@ -3,7 +3,6 @@ package provider
 import (
 	"context"
 	"fmt"
-	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
@@ -17,12 +16,14 @@ func NewRegistryCoreDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &registryCoreDataSource{}
-	_ datasource.DataSourceWithConfigure = &registryCoreDataSource{}
+	_ datasource.DataSource                     = &registryCoreDataSource{}
+	_ datasource.DataSourceWithConfigure        = &registryCoreDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &registryCoreDataSource{}
 )
 
 type registryCoreDataSource struct {
-	client *neos.RegistryCoreClient
+	client          *neos.RegistryCoreClient
+	providerAccount string
 }
 
 func (d *registryCoreDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -30,17 +31,19 @@ func (d *registryCoreDataSource) Metadata(_ context.Context, req datasource.Meta
 }
 
 func (d *registryCoreDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
-	mod := RegistryCoreDataSourceModel{}
-	req.Config.Get(ctx, &mod)
 
-	var state RegistryCoreDataSourceModel
+	tflog.Info(ctx, "registryCoreDataSource READ")
 
-	//state.RegistryCores = append(state.RegistryCores, RegistryCoreModel{})
-	state.RegistryCores = []RegistryCoreModel{}
+	var config RegistryCoreModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	//state.RegistryCores[0].ID = types.StringValue("00000000-0000-0000-0000-000000000000")
+	account := stateProviderDefault(config.Account, d.providerAccount)
 
-	list, err := d.client.Get(mod.Account.ValueString())
+	list, err := d.client.Get(account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to read registry core List",
@@ -49,39 +52,22 @@ func (d *registryCoreDataSource) Read(ctx context.Context, req datasource.ReadRe
 		return
 	}
 
-	// Map response body to model
-	for _, ds := range list.Cores {
-		id := d.splitUrnGetId(ds.Urn)
-		registryCoreState := RegistryCoreModel{
-			ID:      types.StringValue(id),
-			Host:    types.StringValue(ds.Host),
-			Name:    types.StringValue(ds.Name),
-			Urn:     types.StringValue(ds.Urn),
-			Account: types.StringValue(ds.Account),
-		}
-		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", ds.Urn))
-		if (mod.Name.ValueString() == ds.Name) || mod.Name.IsNull() {
-			state.RegistryCores = append(state.RegistryCores, registryCoreState)
-		}
-	}
+	lookup := entityLookup{ID: config.ID, Name: config.Name, URN: config.Urn}
+	matches := filterEntities(list.Cores, func(c neos.RegistryCore) bool {
+		return lookup.matches(registryCoreID(c), c.Name, c.Urn)
+	})
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
-	resp.Diagnostics.Append(diags...)
-	if resp.Diagnostics.HasError() {
+	if !expectSingleEntity(&resp.Diagnostics, "registry core", lookup.String(), len(matches)) {
 		return
 	}
-}
 
-func (d registryCoreDataSource) ValidateConfig(ctx context.Context, req datasource.ValidateConfigRequest, resp *datasource.ValidateConfigResponse) {
-	var data RegistryCoreDataSourceModel
-
-	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+	state := newRegistryCoreModel(matches[0])
 
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
 	}
-
 }
 
 // Configure adds the provider configured client to the data source.
@@ -92,69 +78,107 @@ func (d *registryCoreDataSource) Configure(ctx context.Context, req datasource.C
 		return
 	}
 
-	var client *neos.NeosClient
+	var client *neosProviderData
 	var ok bool
-	client, ok = req.ProviderData.(*neos.NeosClient)
+	client, ok = req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected registryCoreDataSource Configure Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected registryCoreDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 
 		return
 	}
 
 	d.client = &client.RegistryCoreClient
+	d.providerAccount = client.Account
 }
 
-func (d *registryCoreDataSource) Schema(_ context.Context, foo datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+func (d *registryCoreDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "name", "urn")
+}
+
+func (d *registryCoreDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := registryCoreDataSourceAttributes()
+	setLookupAttributes(attributes, "registry core", "id", "name", "urn")
+	attributes["account"] = schema.StringAttribute{
+		Computed:    true,
+		Optional:    true,
+		Required:    false,
+		Description: "The account the core is registered in, defaults to the provider account",
+	}
 
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"account": schema.StringAttribute{
-				Required: true,
-			},
-			"name": schema.StringAttribute{
-				Optional: true,
-			},
-			"registry_cores": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"name": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"host": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"account": schema.StringAttribute{
-							Computed: true,
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type RegistryCoreDataSourceModel struct {
-	RegistryCores []RegistryCoreModel `tfsdk:"registry_cores"`
-	Account       types.String        `tfsdk:"account"`
-	Name          types.String        `tfsdk:"name"`
+// registryCoreDataSourceAttributes are the computed attributes of a core shared
+// by the neos_registry_core and neos_registry_cores data sources.
+func registryCoreDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"name": schema.StringAttribute{
+			Computed: true,
+		},
+		"urn": schema.StringAttribute{
+			Computed: true,
+		},
+		"host": schema.StringAttribute{
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"account": schema.StringAttribute{
+			Computed: true,
+		},
+		"partition": schema.StringAttribute{
+			Computed:    true,
+			Description: "The partition of the core, taken from its URN",
+		},
+		"version": schema.StringAttribute{
+			Computed: true,
+		},
+		"access_key_id": schema.StringAttribute{
+			Computed:    true,
+			Description: "The access key id of the current key pair of the core",
+		},
+	}
 }
 
 type RegistryCoreModel struct {
-	ID      types.String `tfsdk:"id"`
-	Host    types.String `tfsdk:"host"`
-	Urn     types.String `tfsdk:"urn"`
-	Name    types.String `tfsdk:"name"`
-	Account types.String `tfsdk:"account"`
+	ID          types.String `tfsdk:"id"`
+	Host        types.String `tfsdk:"host"`
+	Urn         types.String `tfsdk:"urn"`
+	Name        types.String `tfsdk:"name"`
+	Account     types.String `tfsdk:"account"`
+	Partition   types.String `tfsdk:"partition"`
+	Version     types.String `tfsdk:"version"`
+	AccessKeyId types.String `tfsdk:"access_key_id"`
 }
 
-func (d *registryCoreDataSource) splitUrnGetId(urn string) string {
-	bits := strings.Split(urn, ":")
-	return bits[len(bits)-1]
+func newRegistryCoreModel(c neos.RegistryCore) RegistryCoreModel {
+	partition := ""
+	if urn, ok := parseNeosURN(c.Urn); ok {
+		partition = urn.Partition
+	}
+	return RegistryCoreModel{
+		ID:          types.StringValue(registryCoreID(c)),
+		Host:        types.StringValue(c.Host),
+		Name:        types.StringValue(c.Name),
+		Urn:         types.StringValue(c.Urn),
+		Account:     types.StringValue(c.Account),
+		Partition:   types.StringValue(partition),
+		Version:     types.StringValue(c.Version),
+		AccessKeyId: types.StringValue(c.AccessKey),
+	}
+}
+
+// registryCoreID is the id of the core, older registries only return it as
+// the last part of the URN.
+func registryCoreID(c neos.RegistryCore) string {
+	if c.ID != "" {
+		return c.ID
+	}
+	if urn, ok := parseNeosURN(c.Urn); ok {
+		return urn.ID
+	}
+	return ""
 }
@@ -23,13 +23,17 @@ func NewRegistryCoreResource() resource.Resource {
 
 // registryCoreResource is the resource implementation.
 type registryCoreResource struct {
-	client *neos.RegistryCoreClient
+	client            *neos.RegistryCoreClient
+	apiClient         *neosAPIClient
+	providerAccount   string
+	providerPartition string
 }
 
 var (
 	_ resource.Resource                = &registryCoreResource{}
 	_ resource.ResourceWithConfigure   = &registryCoreResource{}
 	_ resource.ResourceWithImportState = &registryCoreResource{}
+	_ resource.ResourceWithModifyPlan  = &registryCoreResource{}
 )
 
 // Metadata returns the resource type name.
@@ -42,10 +46,10 @@ func (r *registryCoreResource) Schema(_ context.Context, _ resource.SchemaReques
 	resp.Schema = schema.Schema{
 		Attributes: map[string]schema.Attribute{
 			"host": schema.StringAttribute{
-				Computed:    false,
+				Computed:    true,
 				Required:    false,
 				Optional:    true,
-				Description: "The host which is never passed in",
+				Description: "The host of the core, read from the registry",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -54,7 +58,7 @@ func (r *registryCoreResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 				Required:    false,
 				Optional:    false,
-				Description: "The access key id",
+				Description: "The access key id, changes when the keys are rotated",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -63,7 +67,7 @@ func (r *registryCoreResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    true,
 				Required:    false,
 				Optional:    false,
-				Description: "The secret access key ",
+				Description: "The secret access key, only known after the core is created or its keys are rotated",
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.UseStateForUnknown(),
 				},
@@ -90,28 +94,25 @@ func (r *registryCoreResource) Schema(_ context.Context, _ resource.SchemaReques
 				Computed:    false,
 				Required:    true,
 				Optional:    false,
-				Description: "Name of the core",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Description: "Name of the core, renaming updates the core in place",
 			},
-			"account": schema.StringAttribute{
+			"rotate_keys": schema.StringAttribute{
 				Computed:    false,
-				Required:    true,
-				Optional:    false,
-				Description: "Account if not root",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Required:    false,
+				Optional:    true,
+				Description: "An arbitrary value, changing it issues a new key pair for the core and the previous key pair stops working",
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Required:    false,
+				Optional:    true,
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
 			},
 			"partition": schema.StringAttribute{
-				Computed:    false,
-				Required:    true,
-				Optional:    false,
-				Description: "The name of the partition",
-				PlanModifiers: []planmodifier.String{
-					stringplanmodifier.RequiresReplace(),
-				},
+				Computed:    true,
+				Required:    false,
+				Optional:    true,
+				Description: "The name of the partition, defaults to the provider partition. Changing it replaces the resource",
 			},
 		},
 	}
@@ -127,6 +128,7 @@ type registryCoreResourceModel struct {
 	Host        types.String `tfsdk:"host"`
 	Partition   types.String `tfsdk:"partition"`
 	Account     types.String `tfsdk:"account"`
+	RotateKeys  types.String `tfsdk:"rotate_keys"`
 }
 
 // Create a new resource.
@@ -156,6 +158,21 @@ func (r *registryCoreResource) Create(ctx context.Context, req resource.CreateRe
 	plan.SecretKey = types.StringValue(result.KeyPair.SecretAccessKey)
 	plan.URN = types.StringValue(result.Urn)
 
+	// The host is not in the create response, it is read back from the
+	// registry.
+	if plan.Host.IsUnknown() {
+		plan.Host = types.StringValue("")
+		list, err := r.client.Get(plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddWarning("Error reading core host", "Could not read the host of core "+result.Identifier+" from the registry: "+err.Error())
+		}
+		for _, c := range list.Cores {
+			if c.ID == result.Identifier {
+				plan.Host = types.StringValue(c.Host)
+			}
+		}
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -175,20 +192,43 @@ func (r *registryCoreResource) Read(ctx context.Context, req resource.ReadReques
 		return
 	}
 
-	dataSystemList, err := r.client.Get(state.Account.ValueString())
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+	state.Partition = stateProviderDefault(state.Partition, r.providerPartition)
+
+	list, err := r.client.Get(state.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError(
-			"Error Reading NEOS cores from registry", "Could not read NEOS  data system ID "+": "+err.Error())
+			"Error Reading NEOS cores from registry", "Could not read NEOS core "+state.Name.ValueString()+": "+err.Error())
 		return
 	}
 
-	for _, ds := range dataSystemList.Cores {
-		if ds.Name == state.Name.ValueString() {
-			state.Host = types.StringValue(ds.Host)
-			state.Name = types.StringValue(ds.Name)
-			state.URN = types.StringValue(ds.Urn)
-			break
-		}
+	// The core is found by its identifier, an imported core only has its name
+	// until the first read.
+	lookup := entityLookup{ID: state.Identifier, Name: state.Name, URN: types.StringNull()}
+	if state.Identifier.ValueString() == "" {
+		lookup.ID = types.StringNull()
+	}
+	matches := filterEntities(list.Cores, func(c neos.RegistryCore) bool {
+		return lookup.matches(c.ID, c.Name, c.Urn)
+	})
+	if len(matches) == 0 {
+		tflog.Info(ctx, fmt.Sprintf("registryCoreResource core %s not found, removing from state", lookup.String()))
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	core := matches[0]
+
+	state.Identifier = types.StringValue(core.ID)
+	state.Host = types.StringValue(core.Host)
+	state.Name = types.StringValue(core.Name)
+	state.URN = types.StringValue(core.Urn)
+	if urn, ok := parseNeosURN(core.Urn); ok && urn.Partition != "" {
+		state.Partition = types.StringValue(urn.Partition)
+	}
+	// The registry only returns the access key id, the secret is kept from
+	// when it was issued.
+	if core.AccessKey != "" {
+		state.AccessKeyId = types.StringValue(core.AccessKey)
 	}
 
 	diags = resp.State.Set(ctx, &state)
@@ -210,6 +250,41 @@ func (r *registryCoreResource) Update(ctx context.Context, req resource.UpdateRe
 		return
 	}
 
+	var state registryCoreResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	id := state.Identifier.ValueString()
+	plan.Identifier = state.Identifier
+	plan.URN = state.URN
+	if plan.Host.IsUnknown() {
+		plan.Host = state.Host
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		err := r.apiClient.RegistryCorePut(ctx, id, registryCorePutRequest{Name: plan.Name.ValueString()}, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error updating core in registry", "Could not rename core "+id+", unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	if rotateRegistryCoreKeys(plan, state) {
+		keyPair, err := r.apiClient.RegistryCoreKeyPairPost(ctx, id, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error rotating core keys", "Could not issue a new key pair for core "+id+", unexpected error: "+err.Error())
+			return
+		}
+		plan.AccessKeyId = types.StringValue(keyPair.AccessKeyID)
+		plan.SecretKey = types.StringValue(keyPair.SecretAccessKey)
+	} else {
+		plan.AccessKeyId = state.AccessKeyId
+		plan.SecretKey = state.SecretKey
+	}
+
 	diags = resp.State.Set(ctx, plan)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
@@ -217,6 +292,12 @@ func (r *registryCoreResource) Update(ctx context.Context, req resource.UpdateRe
 	}
 }
 
+// rotateRegistryCoreKeys reports if the rotate_keys trigger changed, removing
+// the trigger does not issue new keys.
+func rotateRegistryCoreKeys(plan registryCoreResourceModel, state registryCoreResourceModel) bool {
+	return !plan.RotateKeys.IsNull() && !plan.RotateKeys.Equal(state.RotateKeys)
+}
+
 // Delete deletes the resource and removes the Terraform state on success.
 func (r *registryCoreResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
 
@@ -244,7 +325,7 @@ func (r *registryCoreResource) Configure(_ context.Context, req resource.Configu
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.RegistryCoreClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -252,9 +333,44 @@ func (r *registryCoreResource) Configure(_ context.Context, req resource.Configu
 	}
 
 	r.client = &client.RegistryCoreClient
+	r.apiClient = client.API
+	r.providerAccount = client.Account
+	r.providerPartition = client.Partition
 }
 
+// ModifyPlan defaults the account and partition to the provider's and plans
+// new keys when rotate_keys changes.
+func (r *registryCoreResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
+	planProviderDefault(ctx, req, resp, "partition", r.providerPartition)
+
+	if resp.Diagnostics.HasError() || req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan, state registryCoreResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// An unknown trigger may change once it is known so the keys may rotate.
+	if plan.RotateKeys.IsUnknown() || rotateRegistryCoreKeys(plan, state) {
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("access_key_id"), types.StringUnknown())...)
+		resp.Diagnostics.Append(resp.Plan.SetAttribute(ctx, path.Root("secret_key"), types.StringUnknown())...)
+	}
+}
+
+// ImportState imports a core from <name>, <account>/<name> or its NEOS URN,
+// the registry is read by name.
 func (r *registryCoreResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("urn"), req, resp)
+	importAccountScoped(ctx, req, resp, "name", true, func(urn string, account string) (string, bool, error) {
+		list, err := r.client.Get(account)
+		if err != nil {
+			return "", false, err
+		}
+		name, found := findByURN(list.Cores, urn, func(c neos.RegistryCore) string { return c.Urn }, func(c neos.RegistryCore) string { return c.Name })
+		return name, found, nil
+	})
 }
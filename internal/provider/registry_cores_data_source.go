@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewRegistryCoresDataSource() datasource.DataSource {
+	return &registryCoresDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &registryCoresDataSource{}
+	_ datasource.DataSourceWithConfigure = &registryCoresDataSource{}
+)
+
+type registryCoresDataSource struct {
+	client          *neos.RegistryCoreClient
+	providerAccount string
+}
+
+func (d *registryCoresDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_registry_cores"
+}
+
+func (d *registryCoresDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	var state RegistryCoresDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, ok := nameRegexFilter(&resp.Diagnostics, state.NameRegex)
+	if !ok {
+		return
+	}
+
+	list, err := d.client.Get(stateProviderDefault(state.Account, d.providerAccount).ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to read registry core List", err.Error())
+		return
+	}
+
+	matches := filterEntities(list.Cores, func(c neos.RegistryCore) bool {
+		return nameRegex.MatchString(c.Name)
+	})
+
+	state.RegistryCores = []RegistryCoreModel{}
+	for _, c := range matches {
+		tflog.Info(ctx, fmt.Sprintf("NEOS - ID: %s ", c.Urn))
+		state.RegistryCores = append(state.RegistryCores, newRegistryCoreModel(c))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+}
+
+func (d *registryCoresDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Registry Cores Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected registryCoresDataSource Configure Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = &client.RegistryCoreClient
+	d.providerAccount = client.Account
+}
+
+func (d *registryCoresDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": pluralFilterAttribute("Only return cores whose name matches this regular expression"),
+			"account":    pluralFilterAttribute("The account the cores are registered in, defaults to the provider account"),
+			"registry_cores": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The cores matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: registryCoreDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+type RegistryCoresDataSourceModel struct {
+	NameRegex     types.String        `tfsdk:"name_regex"`
+	Account       types.String        `tfsdk:"account"`
+	RegistryCores []RegistryCoreModel `tfsdk:"registry_cores"`
+}
@@ -6,7 +6,6 @@ import (
 	"fmt"
 	"time"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
@@ -296,7 +295,14 @@ func (r *secretResource) Configure(_ context.Context, req resource.ConfigureRequ
 	r.client = client
 }
 
+// ImportState imports the resource by its id or NEOS URN.
 func (r *secretResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importByIDOrURN(ctx, req, resp, "id", func(urn string, _ string) (string, bool, error) {
+		list, err := r.client.Get()
+		if err != nil {
+			return "", false, err
+		}
+		id, found := findByURN(list.Secrets, urn, func(e neos.Secret) string { return e.Urn }, func(e neos.Secret) string { return e.Identifier })
+		return id, found, nil
+	})
 }
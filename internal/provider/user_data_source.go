@@ -16,8 +16,9 @@ func NewUserDataSource() datasource.DataSource {
 }
 
 var (
-	_ datasource.DataSource              = &userDataSource{}
-	_ datasource.DataSourceWithConfigure = &userDataSource{}
+	_ datasource.DataSource                     = &userDataSource{}
+	_ datasource.DataSourceWithConfigure        = &userDataSource{}
+	_ datasource.DataSourceWithConfigValidators = &userDataSource{}
 )
 
 type userDataSource struct {
@@ -32,32 +33,32 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	tflog.Info(ctx, "userDataSource READ")
 
-	var state UserDataSourceModel
+	var config UserModel
+	diags := req.Config.Get(ctx, &config)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
 
-	list, err := d.client.List("", "", "")
+	list, err := d.client.List("", "", config.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Unable to Read User List", err.Error())
 		return
 	}
 
-	// Map response body to model
-	for _, ds := range list.Users {
-		userState := UserModel{
-			ID:        types.StringValue(ds.Identifier),
-			FirstName: types.StringValue(ds.FirstName),
-			LastName:  types.StringValue(ds.LastName),
-			Username:  types.StringValue(ds.Username),
-			URN:       types.StringValue(ds.Urn),
-			Email:     types.StringValue(ds.Email),
-			Enabled:   types.BoolValue(ds.Enabled),
-			IsSystem:  types.BoolValue(ds.IsSystem),
-			Account:   types.StringValue(ds.Account),
-		}
-		state.UserModel = append(state.UserModel, userState)
+	// the name of a user is its username
+	lookup := entityLookup{ID: config.ID, Name: config.Username, URN: config.URN}
+	matches := filterEntities(list.Users, func(u neos.User) bool {
+		return lookup.matches(u.Identifier, u.Username, u.Urn)
+	})
+
+	if !expectSingleEntity(&resp.Diagnostics, "user", lookup.String(), len(matches)) {
+		return
 	}
 
-	// Set state
-	diags := resp.State.Set(ctx, &state)
+	state := newUserModel(matches[0])
+
+	diags = resp.State.Set(ctx, &state)
 	resp.Diagnostics.Append(diags...)
 	if resp.Diagnostics.HasError() {
 		return
@@ -65,7 +66,6 @@ func (d *userDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 }
 
-// Configure adds the provider configured client to the data source.
 func (d *userDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
 	tflog.Info(ctx, "Data source configure")
 
@@ -73,58 +73,66 @@ func (d *userDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
-		resp.Diagnostics.AddError("Unexpected userDataSource Type", fmt.Sprintf("Expected *neos.NeosClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		resp.Diagnostics.AddError("Unexpected userDataSource Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
 	}
 
 	d.client = &client.UserClient
 }
 
+func (d *userDataSource) ConfigValidators(_ context.Context) []datasource.ConfigValidator {
+	return lookupConfigValidators("id", "username", "urn")
+}
+
 func (d *userDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	attributes := userDataSourceAttributes()
+	setLookupAttributes(attributes, "user", "id", "username", "urn")
+	attributes["account"] = schema.StringAttribute{
+		Computed:    true,
+		Optional:    true,
+		Required:    false,
+		Description: "The account to look the user up in",
+	}
+
 	resp.Schema = schema.Schema{
-		Attributes: map[string]schema.Attribute{
-			"user": schema.ListNestedAttribute{
-				Computed: true,
-				NestedObject: schema.NestedAttributeObject{
-					Attributes: map[string]schema.Attribute{
-						"first_name": schema.StringAttribute{
-							Computed: true,
-						},
-						"last_name": schema.StringAttribute{
-							Computed: true,
-						},
-						"username": schema.StringAttribute{
-							Computed: true,
-						},
-						"email": schema.StringAttribute{
-							Computed: true,
-						},
-						"urn": schema.StringAttribute{
-							Computed: true,
-						},
-						"id": schema.StringAttribute{
-							Computed: true,
-						},
-						"enabled": schema.BoolAttribute{
-							Computed: true,
-						},
-						"is_system": schema.BoolAttribute{
-							Computed: true,
-						},
-						"account": schema.StringAttribute{
-							Computed: true,
-						},
-					},
-				},
-			},
-		},
+		Attributes: attributes,
 	}
 }
 
-type UserDataSourceModel struct {
-	UserModel []UserModel `tfsdk:"user"`
+// userDataSourceAttributes are the computed attributes of a user shared by the
+// neos_user and neos_users data sources.
+func userDataSourceAttributes() map[string]schema.Attribute {
+	return map[string]schema.Attribute{
+		"first_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"last_name": schema.StringAttribute{
+			Computed: true,
+		},
+		"username": schema.StringAttribute{
+			Computed: true,
+		},
+		"email": schema.StringAttribute{
+			Computed: true,
+		},
+		"urn": schema.StringAttribute{
+			Computed: true,
+		},
+		"id": schema.StringAttribute{
+			Computed: true,
+		},
+		"enabled": schema.BoolAttribute{
+			Computed: true,
+		},
+		"is_system": schema.BoolAttribute{
+			Computed: true,
+		},
+		"account": schema.StringAttribute{
+			Computed: true,
+		},
+	}
 }
 
 type UserModel struct {
@@ -137,5 +145,18 @@ type UserModel struct {
 	Enabled   types.Bool   `tfsdk:"enabled"`
 	IsSystem  types.Bool   `tfsdk:"is_system"`
 	Account   types.String `tfsdk:"account"`
-	//LastUpdated types.String `tfsdk:"last_updated"`
+}
+
+func newUserModel(u neos.User) UserModel {
+	return UserModel{
+		ID:        types.StringValue(u.Identifier),
+		FirstName: types.StringValue(u.FirstName),
+		LastName:  types.StringValue(u.LastName),
+		Username:  types.StringValue(u.Username),
+		URN:       types.StringValue(u.Urn),
+		Email:     types.StringValue(u.Email),
+		Enabled:   types.BoolValue(u.Enabled),
+		IsSystem:  types.BoolValue(u.IsSystem),
+		Account:   types.StringValue(u.Account),
+	}
 }
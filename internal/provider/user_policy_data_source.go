@@ -75,7 +75,7 @@ func (d *userPolicyDataSource) Configure(ctx context.Context, req datasource.Con
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected userPolicyDataSource Configure Type", fmt.Sprintf("Expected *neos.PolicyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
 		return
@@ -5,7 +5,6 @@ import (
 	"encoding/json"
 	"fmt"
 	jt "github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -21,13 +20,15 @@ func NewUserPolicyResource() resource.Resource {
 
 // userPolicyResource is the resource implementation.
 type userPolicyResource struct {
-	client *neos.PolicyClient
+	client          *neos.PolicyClient
+	providerAccount string
 }
 
 var (
 	_ resource.Resource                = &userPolicyResource{}
 	_ resource.ResourceWithConfigure   = &userPolicyResource{}
 	_ resource.ResourceWithImportState = &userPolicyResource{}
+	_ resource.ResourceWithModifyPlan  = &userPolicyResource{}
 )
 
 // Metadata returns the resource type name.
@@ -62,10 +63,10 @@ func (r *userPolicyResource) Schema(_ context.Context, _ resource.SchemaRequest,
 				Description: "the Policy",
 			},
 			"account": schema.StringAttribute{
-				Computed:    false,
+				Computed:    true,
 				Optional:    true,
 				Required:    false,
-				Description: "account",
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
 			},
 			"last_updated": schema.StringAttribute{
 				Computed: true,
@@ -99,7 +100,9 @@ func (r *userPolicyResource) Create(ctx context.Context, req resource.CreateRequ
 		Policy: ss,
 	}
 
+	unlock := lockPrincipalPolicy(plan.Account.ValueString(), plan.ID.ValueString())
 	_, err := r.client.Post(ctx, item, plan.Account.ValueString())
+	unlock()
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating policy", "Could not create policy, unexpected error: "+err.Error())
 		return
@@ -137,6 +140,8 @@ func (r *userPolicyResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+
 	// TODO: need to not hard code the paritition to ksa
 	//nrn := fmt.Sprintf("nrn:ksa:iam::%s:user:%s", state.Account.ValueString(), )
 
@@ -186,7 +191,9 @@ func (r *userPolicyResource) Update(ctx context.Context, req resource.UpdateRequ
 		Policy: ss,
 	}
 
+	unlock := lockPrincipalPolicy(plan.Account.ValueString(), plan.ID.ValueString())
 	_, err := r.client.Put(ctx, plan.ID.ValueString(), dspr, plan.Account.ValueString())
+	unlock()
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating userPolicy", "Could not put userPolicy, unexpected error: "+err.Error())
 		return
@@ -217,7 +224,9 @@ func (r *userPolicyResource) Delete(ctx context.Context, req resource.DeleteRequ
 		return
 	}
 
+	unlock := lockPrincipalPolicy(plan.Account.ValueString(), plan.ID.ValueString())
 	err := r.client.Delete(ctx, plan.ID.ValueString(), plan.Account.ValueString())
+	unlock()
 	if err != nil {
 		resp.Diagnostics.AddError("Error deleting userPolicy", "Could not delete userPolicy, unexpected error: "+err.Error())
 		return
@@ -228,7 +237,7 @@ func (r *userPolicyResource) Configure(_ context.Context, req resource.Configure
 	if req.ProviderData == nil {
 		return
 	}
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.PolicyClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -236,10 +245,17 @@ func (r *userPolicyResource) Configure(_ context.Context, req resource.Configure
 	}
 
 	r.client = &client.PolicyClient
+	r.providerAccount = client.Account
+
+}
 
+// ModifyPlan defaults the account to the provider's.
+func (r *userPolicyResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
 }
 
+// ImportState imports the policy of a user from <user_id>, <account>/<user_id>
+// or the NEOS URN of the user.
 func (r *userPolicyResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importAccountScoped(ctx, req, resp, "id", false, nil)
 }
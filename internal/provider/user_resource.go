@@ -5,11 +5,14 @@ import (
 	"fmt"
 
 	"encoding/json"
+	"os"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
@@ -29,13 +32,16 @@ func NewUserResource() resource.Resource {
 
 // userResource is the resource implementation.
 type userResource struct {
-	client *neos.UserClient
+	client          *neos.UserClient
+	apiClient       *neosAPIClient
+	providerAccount string
 }
 
 var (
 	_ resource.Resource                = &userResource{}
 	_ resource.ResourceWithConfigure   = &userResource{}
 	_ resource.ResourceWithImportState = &userResource{}
+	_ resource.ResourceWithModifyPlan  = &userResource{}
 )
 
 // Metadata returns the resource type name.
@@ -120,16 +126,36 @@ func (r *userResource) Schema(_ context.Context, _ resource.SchemaRequest, resp
 				// },
 			},
 			"enabled": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Default:     booldefault.StaticBool(true),
+				Description: "If the user is enabled, set to false to disable the user without deleting it",
+			},
+			"initial_password_env": schema.StringAttribute{
 				Computed:    false,
-				Optional:    false,
-				Required:    true,
-				Description: "user enabled",
+				Optional:    true,
+				Required:    false,
+				Description: "Name of the environment variable holding the password to set for the user. The password is read when the user is created or initial_password_version changes, so it is never in the configuration, plan or state",
 			},
-			"account": schema.StringAttribute{
+			"initial_password_version": schema.Int64Attribute{
 				Computed:    false,
 				Optional:    true,
 				Required:    false,
-				Description: "account if not root",
+				Description: "Change this to set the password from initial_password_env again",
+			},
+			"send_invite": schema.BoolAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Default:     booldefault.StaticBool(false),
+				Description: "Send the user an invite email when the user is created",
+			},
+			"account": schema.StringAttribute{
+				Computed:    true,
+				Optional:    true,
+				Required:    false,
+				Description: "The account, defaults to the provider account. Changing it replaces the resource",
 			},
 			"is_system": schema.BoolAttribute{
 				Computed:    true,
@@ -156,6 +182,28 @@ type userResourceModel struct {
 	IsSystem    types.Bool   `tfsdk:"is_system"`
 	LastUpdated types.String `tfsdk:"last_updated"`
 	Account     types.String `tfsdk:"account"`
+
+	InitialPasswordEnv     types.String `tfsdk:"initial_password_env"`
+	InitialPasswordVersion types.Int64  `tfsdk:"initial_password_version"`
+	SendInvite             types.Bool   `tfsdk:"send_invite"`
+}
+
+// initialPassword reads the password from the environment variable named by
+// initial_password_env, it is not set when the attribute is null.
+func (m userResourceModel) initialPassword(diags *diag.Diagnostics) (string, bool) {
+	if m.InitialPasswordEnv.IsNull() {
+		return "", false
+	}
+	password := os.Getenv(m.InitialPasswordEnv.ValueString())
+	if password == "" {
+		diags.AddAttributeError(
+			path.Root("initial_password_env"),
+			"Missing initial password",
+			fmt.Sprintf("The environment variable %q named by initial_password_env is not set or is empty.", m.InitialPasswordEnv.ValueString()),
+		)
+		return "", false
+	}
+	return password, true
 }
 
 // Create a new resource.
@@ -178,12 +226,57 @@ func (r *userResource) Create(ctx context.Context, req resource.CreateRequest, r
 		Email:     plan.Email.ValueString(),
 	}
 
+	// Read the password before the user exists so a missing variable does not
+	// leave a user behind.
+	password, setPassword := plan.initialPassword(&resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	result, err := r.client.Post(ctx, item, plan.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error creating user", "Could not create user, unexpected error: "+err.Error())
 		return
 	}
 	id := result.Identifier
+
+	// The user is saved first so one whose password, enabled state or invite
+	// fails is known to Terraform, and is replaced by the next apply.
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), id)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("account"), plan.Account)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if setPassword {
+		err = r.apiClient.UserPasswordPut(ctx, id, password, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating user", "Could not set the password of user "+id+", unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	if !plan.Enabled.ValueBool() {
+		result, err = r.apiClient.UserPut(ctx, id, userPutRequest{
+			FirstName: result.FirstName,
+			LastName:  result.LastName,
+			Email:     result.Email,
+			Enabled:   false,
+		}, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating user", "Could not disable user "+id+", unexpected error: "+err.Error())
+			return
+		}
+	}
+
+	if plan.SendInvite.ValueBool() {
+		err = r.apiClient.UserInvitePost(ctx, id, plan.Account.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddError("Error creating user", "Could not send an invite to user "+id+", unexpected error: "+err.Error())
+			return
+		}
+	}
+
 	plan.ID = types.StringValue(id)
 	plan.LastName = types.StringValue(result.LastName)
 	plan.URN = types.StringValue(result.Urn)
@@ -216,6 +309,8 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 		return
 	}
 
+	state.Account = stateProviderDefault(state.Account, r.providerAccount)
+
 	userList, err := r.client.List("", "", state.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error Reading NEOS user", "Could not read NEOS  user ID "+state.ID.ValueString()+": "+err.Error())
@@ -235,6 +330,10 @@ func (r *userResource) Read(ctx context.Context, req resource.ReadRequest, resp
 			state.IsSystem = types.BoolValue(ds.IsSystem)
 			state.URN = types.StringValue(ds.Urn)
 			state.Account = types.StringValue(state.Account.ValueString())
+			// send_invite only applies at create so an imported user has not been invited
+			if state.SendInvite.IsNull() {
+				state.SendInvite = types.BoolValue(false)
+			}
 			break
 		}
 	}
@@ -257,26 +356,45 @@ func (r *userResource) Update(ctx context.Context, req resource.UpdateRequest, r
 		return
 	}
 
-	dspr := neos.UserPostRequest{
-		Username:  plan.Username.ValueString(),
+	var state userResourceModel
+	diags = req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	dspr := userPutRequest{
 		LastName:  plan.LastName.ValueString(),
 		FirstName: plan.FirstName.ValueString(),
 		Email:     plan.Email.ValueString(),
+		Enabled:   plan.Enabled.ValueBool(),
 	}
 
-	result, err := r.client.Post(ctx, dspr, plan.Account.ValueString())
+	result, err := r.apiClient.UserPut(ctx, plan.ID.ValueString(), dspr, plan.Account.ValueString())
 	if err != nil {
 		resp.Diagnostics.AddError("Error updating user", "Could not put user, unexpected error: "+err.Error())
 		return
 	}
 
-	plan.ID = types.StringValue(result.Identifier)
+	// the password is not in state so it is only sent again when the variable
+	// or the version changes
+	if !plan.InitialPasswordEnv.Equal(state.InitialPasswordEnv) || !plan.InitialPasswordVersion.Equal(state.InitialPasswordVersion) {
+		password, setPassword := plan.initialPassword(&resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		if setPassword {
+			err = r.apiClient.UserPasswordPut(ctx, plan.ID.ValueString(), password, plan.Account.ValueString())
+			if err != nil {
+				resp.Diagnostics.AddError("Error updating user", "Could not set the password of user "+plan.ID.ValueString()+", unexpected error: "+err.Error())
+				return
+			}
+		}
+	}
+
 	plan.FirstName = types.StringValue(result.FirstName)
-	plan.URN = types.StringValue(result.Urn)
 	plan.LastName = types.StringValue(result.LastName)
 	plan.Email = types.StringValue(result.Email)
-	plan.Account = types.StringValue(result.Account)
-	plan.Username = types.StringValue(result.Username)
 	plan.Enabled = types.BoolValue(result.Enabled)
 	plan.IsSystem = types.BoolValue(result.IsSystem)
 	plan.LastUpdated = types.StringValue(time.Now().Format(time.RFC850))
@@ -311,7 +429,7 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 		return
 	}
 
-	client, ok := req.ProviderData.(*neos.NeosClient)
+	client, ok := req.ProviderData.(*neosProviderData)
 
 	if !ok {
 		resp.Diagnostics.AddError("Unexpected Data Source Configure Type", fmt.Sprintf("Expected *neos.UserClient, got: %T. Please report this issue to the provider developers.", req.ProviderData))
@@ -319,10 +437,24 @@ func (r *userResource) Configure(_ context.Context, req resource.ConfigureReques
 	}
 
 	r.client = &client.UserClient
+	r.providerAccount = client.Account
+	r.apiClient = client.API
 
 }
 
+// ModifyPlan defaults the account to the provider's.
+func (r *userResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	planProviderDefault(ctx, req, resp, "account", r.providerAccount)
+}
+
+// ImportState imports a user from <id>, <account>/<id> or its NEOS URN.
 func (r *userResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	// Retrieve import ID and save to id attribute
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	importAccountScoped(ctx, req, resp, "id", false, func(urn string, account string) (string, bool, error) {
+		list, err := r.client.List("", "", account)
+		if err != nil {
+			return "", false, err
+		}
+		id, found := findByURN(list.Users, urn, func(u neos.User) string { return u.Urn }, func(u neos.User) string { return u.Identifier })
+		return id, found, nil
+	})
 }
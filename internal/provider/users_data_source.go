@@ -0,0 +1,106 @@
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	neos "github.com/owain-nortal/neos-client-go"
+)
+
+func NewUsersDataSource() datasource.DataSource {
+	return &usersDataSource{}
+}
+
+var (
+	_ datasource.DataSource              = &usersDataSource{}
+	_ datasource.DataSourceWithConfigure = &usersDataSource{}
+)
+
+type usersDataSource struct {
+	client *neos.UserClient
+}
+
+func (d *usersDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_users"
+}
+
+func (d *usersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+
+	tflog.Info(ctx, "usersDataSource READ")
+
+	var state UsersDataSourceModel
+	diags := req.Config.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	nameRegex, ok := nameRegexFilter(&resp.Diagnostics, state.NameRegex)
+	if !ok {
+		return
+	}
+
+	list, err := d.client.List("", "", state.Account.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError("Unable to Read User List", err.Error())
+		return
+	}
+
+	matches := filterEntities(list.Users, func(u neos.User) bool {
+		return nameRegex.MatchString(u.Username)
+	})
+
+	state.Users = []UserModel{}
+	for _, u := range matches {
+		state.Users = append(state.Users, newUserModel(u))
+	}
+
+	diags = resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+}
+
+func (d *usersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	tflog.Info(ctx, "Data source configure")
+
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*neosProviderData)
+	if !ok {
+		resp.Diagnostics.AddError("Unexpected usersDataSource Type", fmt.Sprintf("Expected *neosProviderData, got: %T. Please report this issue to the provider developers.", req.ProviderData))
+		return
+	}
+
+	d.client = &client.UserClient
+}
+
+func (d *usersDataSource) Schema(_ context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"name_regex": pluralFilterAttribute("Only return users whose username matches this regular expression"),
+			"account":    pluralFilterAttribute("Only return users in this account"),
+			"users": schema.ListNestedAttribute{
+				Computed:    true,
+				Description: "The users matching the filters",
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: userDataSourceAttributes(),
+				},
+			},
+		},
+	}
+}
+
+type UsersDataSourceModel struct {
+	NameRegex types.String `tfsdk:"name_regex"`
+	Account   types.String `tfsdk:"account"`
+	Users     []UserModel  `tfsdk:"users"`
+}
@@ -74,7 +74,9 @@ resource "neos_data_product" "test-dp" {
         "primary"     = false
         "optional"    = true
         "data_type" = {
-          meta = {}
+          meta = {
+            "length" : "100"
+          }
           "column_type" : "VARCHAR"
         }
       },
@@ -84,10 +86,8 @@ resource "neos_data_product" "test-dp" {
 
 
 resource "neos_data_product_builder" "test-dp" {
-  id                         = neos_data_product.test-dp.id
-  dataunit_datasource_linkids = [
-    neos_link_data_source_data_unit.link_foo_source_foo_unit.id
-  ]
+  id           = neos_data_product.test-dp.id
+  manage_links = true
   builder_json = jsonencode(
     {
       "config" : {